@@ -0,0 +1,590 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/slntopp/nocloud/pkg/nocloud"
+	"github.com/streadway/amqp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Reconnect delay doubles after every failed attempt up to the max
+var (
+	ReconnectMinBackoff = time.Second
+	ReconnectMaxBackoff = 30 * time.Second
+)
+
+// ErrUnavailable is returned while connection to RabbitMQ is being recovered
+var ErrUnavailable = status.Error(codes.Unavailable, "Broker is unavailable")
+
+// AMQPBroker routes events through the topic exchange, so they reach subscribers of every instance.
+// Connection is supervised: once it's lost, broker reconnects and restores queues of active subscriptions
+type AMQPBroker struct {
+	url      string
+	log      *zap.Logger
+	title    string
+	prefetch int
+
+	// ch is used by subscriptions, pub by the publisher goroutine only
+	mu      sync.RWMutex
+	conn    *amqp.Connection
+	ch      *amqp.Channel
+	pub     amqpChannel
+	confirm *confirmChannel
+	subs    map[*amqpSubscription]struct{}
+
+	// serializes confirmed publishing, confirms are matched by delivery tags
+	confirmMu sync.Mutex
+
+	publishes chan publishing
+	done      chan struct{}
+}
+
+// amqpChannel is the part of *amqp.Channel the publisher goroutine and supervision use
+type amqpChannel interface {
+	Publish(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error
+	NotifyClose(c chan *amqp.Error) chan *amqp.Error
+	Close() error
+}
+
+// Publishings waiting for the publisher goroutine, publishing fails fast once the queue is full
+const PublishQueueSize = 1024
+
+// Time publish waits for the publisher goroutine, channel blocked by flow control doesn't hang requests
+var PublishTimeout = 5 * time.Second
+
+type publishing struct {
+	ctx    context.Context
+	key    string
+	msg    amqp.Publishing
+	result chan error
+}
+
+// confirmChannel is a channel in confirm mode, delivery tags start from 1 on every new channel
+type confirmChannel struct {
+	ch       *amqp.Channel
+	confirms chan amqp.Confirmation
+	tag      uint64
+}
+
+// Confirms of publishings which have timed out are buffered until the next confirmed publish drains them
+const confirmsBuffer = 128
+
+// NewAMQPBroker connects to RabbitMQ, prefetch limits unacked deliveries per subscription
+func NewAMQPBroker(logger *zap.Logger, url string, prefetch int) (*AMQPBroker, error) {
+	b := &AMQPBroker{
+		url:       url,
+		log:       logger.Named("ChatsExchange"),
+		title:     ChatExchange,
+		prefetch:  prefetch,
+		subs:      make(map[*amqpSubscription]struct{}),
+		publishes: make(chan publishing, PublishQueueSize),
+		done:      make(chan struct{}),
+	}
+	if err := b.connect(); err != nil {
+		return nil, err
+	}
+	go b.supervise()
+	go b.publisher()
+	return b, nil
+}
+
+// Close stops recovery and closes connection
+func (b *AMQPBroker) Close() error {
+	close(b.done)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.conn == nil || b.conn.IsClosed() {
+		return nil
+	}
+	return b.conn.Close()
+}
+
+// connect dials RabbitMQ unless connection is still alive, then opens channels and declares the exchange
+func (b *AMQPBroker) connect() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.conn == nil || b.conn.IsClosed() {
+		conn, err := amqp.Dial(b.url)
+		if err != nil {
+			return err
+		}
+		b.conn = conn
+	}
+
+	var opened []*amqp.Channel
+	fail := func(err error) error {
+		for _, ch := range opened {
+			_ = ch.Close()
+		}
+		return err
+	}
+	for i := 0; i < 3; i++ {
+		ch, err := b.conn.Channel()
+		if err != nil {
+			return fail(err)
+		}
+		opened = append(opened, ch)
+	}
+	ch, pub, confirm := opened[0], opened[1], opened[2]
+
+	if err := ch.ExchangeDeclare(b.title, "topic", true, false, false, false, nil); err != nil {
+		return fail(err)
+	}
+	if err := ch.Qos(b.prefetch, 0, false); err != nil {
+		return fail(err)
+	}
+	if err := confirm.Confirm(false); err != nil {
+		return fail(err)
+	}
+
+	b.ch, b.pub = ch, pub
+	b.confirm = &confirmChannel{
+		ch:       confirm,
+		confirms: confirm.NotifyPublish(make(chan amqp.Confirmation, confirmsBuffer)),
+	}
+	return nil
+}
+
+// channel returns current channel, nil while recovering
+func (b *AMQPBroker) channel() *amqp.Channel {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.ch
+}
+
+// supervise waits for channels to close, which also happens when connection is lost, and recovers them
+func (b *AMQPBroker) supervise() {
+	for {
+		b.mu.RLock()
+		channels := []amqpChannel{b.ch, b.pub, b.confirm.ch}
+		b.mu.RUnlock()
+
+		select {
+		case <-b.done:
+			return
+		case err := <-notifyClose(channels...):
+			b.log.Warn("RabbitMQ channel closed, recovering", zap.Error(err))
+		}
+
+		// All channels are reopened, the ones still open are closed to keep them in sync
+		for _, ch := range channels {
+			_ = ch.Close()
+		}
+		b.mu.Lock()
+		b.ch, b.pub, b.confirm = nil, nil, nil
+		b.mu.Unlock()
+
+		if !b.reconnect() {
+			return
+		}
+		b.restore()
+	}
+}
+
+// notifyClose fans in close notifications of the channels, first one closed is reported
+func notifyClose(channels ...amqpChannel) <-chan *amqp.Error {
+	out := make(chan *amqp.Error, len(channels))
+	for _, ch := range channels {
+		closed := ch.NotifyClose(make(chan *amqp.Error, 1))
+		go func() {
+			err := <-closed
+			out <- err
+		}()
+	}
+	return out
+}
+
+func (b *AMQPBroker) reconnect() bool {
+	backoff := ReconnectMinBackoff
+	for {
+		select {
+		case <-b.done:
+			return false
+		case <-time.After(backoff):
+		}
+
+		err := b.connect()
+		if err == nil {
+			b.log.Info("RabbitMQ connection recovered")
+			return true
+		}
+
+		backoff *= 2
+		if backoff > ReconnectMaxBackoff {
+			backoff = ReconnectMaxBackoff
+		}
+		b.log.Warn("Failed to reconnect to RabbitMQ", zap.Error(err), zap.Duration("retry", backoff))
+	}
+}
+
+// restore re-declares queues and bindings of active subscriptions on the new channel
+func (b *AMQPBroker) restore() {
+	b.mu.RLock()
+	subs := make([]*amqpSubscription, 0, len(b.subs))
+	for sub := range b.subs {
+		subs = append(subs, sub)
+	}
+	b.mu.RUnlock()
+
+	// Subscription which isn't restored would never get events again, it's ended
+	// instead, so its consumer resubscribes and replays what it has missed
+	for _, sub := range subs {
+		if err := sub.declare(); err != nil {
+			b.log.Warn("Failed to restore subscription, ending it", zap.String("queue", sub.queue), zap.Error(err))
+			sub.lose()
+		}
+	}
+}
+
+// publish hands the message over to the publisher goroutine and waits for the result,
+// until the context is done or PublishTimeout is over
+func (b *AMQPBroker) publish(ctx context.Context, key string, msg amqp.Publishing) error {
+	ctx, cancel := context.WithTimeout(ctx, PublishTimeout)
+	defer cancel()
+
+	p := publishing{ctx: ctx, key: key, msg: msg, result: make(chan error, 1)}
+	select {
+	case b.publishes <- p:
+	default:
+		b.log.Warn("Publish queue is full, dropping event", zap.String("key", key), zap.String("type", msg.Type))
+		return ErrUnavailable
+	}
+
+	select {
+	case err := <-p.result:
+		return err
+	case <-ctx.Done():
+		b.log.Warn("Publish timed out", zap.String("key", key), zap.String("type", msg.Type), zap.Error(ctx.Err()))
+		return ErrUnavailable
+	case <-b.done:
+		return ErrUnavailable
+	}
+}
+
+// publisher owns the publish channel, so publishings never interleave on it
+func (b *AMQPBroker) publisher() {
+	for {
+		select {
+		case <-b.done:
+			return
+		case p := <-b.publishes:
+			p.result <- b.send(p)
+		}
+	}
+}
+
+func (b *AMQPBroker) send(p publishing) error {
+	// Publisher has given up on it while it was queued
+	if p.ctx.Err() != nil {
+		return ErrUnavailable
+	}
+
+	b.mu.RLock()
+	pub := b.pub
+	b.mu.RUnlock()
+	if pub == nil {
+		return ErrUnavailable
+	}
+
+	err := pub.Publish(b.title, p.key, false, false, p.msg)
+	if errors.Is(err, amqp.ErrClosed) {
+		return ErrUnavailable
+	}
+	return err
+}
+
+func (b *AMQPBroker) Publish(ctx context.Context, key, event string, body []byte) error {
+	return b.publish(ctx, key, amqp.Publishing{
+		ContentType: "text/plain",
+		Type:        event,
+		Body:        body,
+	})
+}
+
+func (b *AMQPBroker) PublishTransient(ctx context.Context, key, event string, body []byte, ttl time.Duration) error {
+	return b.publish(ctx, key, amqp.Publishing{
+		ContentType: "text/plain",
+		Type:        event,
+		Body:        body,
+		Expiration:  strconv.FormatInt(ttl.Milliseconds(), 10),
+	})
+}
+
+// PublishConfirmed waits for the broker to ack the event or for the context to be done
+func (b *AMQPBroker) PublishConfirmed(ctx context.Context, key, event string, body []byte) error {
+	b.confirmMu.Lock()
+	defer b.confirmMu.Unlock()
+
+	b.mu.RLock()
+	confirm := b.confirm
+	b.mu.RUnlock()
+	if confirm == nil {
+		return ErrUnavailable
+	}
+
+	err := confirm.ch.Publish(b.title, key, false, false, amqp.Publishing{
+		ContentType: "text/plain",
+		Type:        event,
+		Body:        body,
+	})
+	if errors.Is(err, amqp.ErrClosed) {
+		return ErrUnavailable
+	}
+	if err != nil {
+		return err
+	}
+	confirm.tag++
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case c, ok := <-confirm.confirms:
+			if !ok {
+				return ErrUnavailable
+			}
+			// Late confirm of the publishing which has timed out before
+			if c.DeliveryTag < confirm.tag {
+				continue
+			}
+			if !c.Ack {
+				return errors.New("event was rejected by broker")
+			}
+			return nil
+		}
+	}
+}
+
+// amqpSubscription is an exclusive auto-delete queue bound to a set of routing keys in the chats exchange,
+// it's deleted once consumer is cancelled. Keys are kept to bind the queue again after recovery
+type amqpSubscription struct {
+	broker   *AMQPBroker
+	ctx      context.Context
+	queue    string
+	consumer string
+
+	mu   sync.Mutex
+	ch   *amqp.Channel
+	keys map[string]bool
+
+	// consumers of the recovered channels are handed over to pump
+	feeds      chan (<-chan amqp.Delivery)
+	deliveries chan Delivery
+
+	// closed if subscription can't be restored, pump ends it then
+	lost     chan struct{}
+	loseOnce sync.Once
+}
+
+func (b *AMQPBroker) Subscribe(ctx context.Context, keys ...string) (Subscription, error) {
+	payload := ctx.Value(nocloud.NoCloudAccount)
+	if payload == nil {
+		return nil, errors.New("empty credentials")
+	}
+	requestor := payload.(string)
+	timestamp := time.Now().String()
+
+	sub := &amqpSubscription{
+		broker:     b,
+		ctx:        ctx,
+		queue:      timestamp + requestor + strings.Join(keys, ","),
+		consumer:   timestamp + requestor,
+		keys:       make(map[string]bool, len(keys)),
+		feeds:      make(chan (<-chan amqp.Delivery), 1),
+		deliveries: make(chan Delivery),
+		lost:       make(chan struct{}),
+	}
+	for _, key := range keys {
+		sub.keys[key] = true
+	}
+
+	b.mu.Lock()
+	b.subs[sub] = struct{}{}
+	b.mu.Unlock()
+
+	if err := sub.declare(); err != nil {
+		b.forget(sub)
+		return nil, err
+	}
+	go sub.pump()
+
+	return sub, nil
+}
+
+func (b *AMQPBroker) forget(sub *amqpSubscription) {
+	b.mu.Lock()
+	delete(b.subs, sub)
+	b.mu.Unlock()
+}
+
+// declare sets up queue, its bindings and consumer on the current channel unless it's done already
+func (s *amqpSubscription) declare() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ch := s.broker.channel()
+	if ch == nil {
+		return ErrUnavailable
+	}
+	if ch == s.ch {
+		return nil
+	}
+
+	_, err := ch.QueueDeclare(
+		s.queue, // name
+		false,   // durable
+		true,    // delete when unused
+		true,    // exclusive
+		false,   // no-wait
+		nil,     // arguments
+	)
+	if err != nil {
+		s.broker.log.Warn("Failed to declare queue")
+		return err
+	}
+
+	for key := range s.keys {
+		if err := ch.QueueBind(s.queue, key, s.broker.title, false, nil); err != nil {
+			s.broker.log.Warn("Failed to bind queue", zap.String("key", key))
+			return err
+		}
+	}
+
+	msgs, err := ch.Consume(
+		s.queue,    // queue
+		s.consumer, // consumer
+		false,      // auto ack
+		true,       // exclusive
+		false,      // no local
+		false,      // no wait
+		nil,        // args
+	)
+	if err != nil {
+		s.broker.log.Warn("Failed to consume exchange")
+		return err
+	}
+	s.ch = ch
+
+	// Handing over never blocks with s.mu held, feed pump hasn't taken yet
+	// is of the previous channel, closed already, so it's replaced
+	for {
+		select {
+		case s.feeds <- msgs:
+			return nil
+		case <-s.feeds:
+		}
+	}
+}
+
+func (s *amqpSubscription) lose() {
+	s.loseOnce.Do(func() { close(s.lost) })
+}
+
+// cancel stops the consumer, so the queue is deleted and unacked deliveries are dropped with it
+func (s *amqpSubscription) cancel() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ch == nil {
+		return
+	}
+	if err := s.ch.Cancel(s.consumer, false); err != nil && !errors.Is(err, amqp.ErrClosed) {
+		s.broker.log.Warn("Failed to cancel consumer", zap.String("queue", s.queue), zap.Error(err))
+	}
+}
+
+// pump forwards deliveries of the current consumer until subscription context is done or it's lost,
+// consumer closed with the channel is replaced once subscription is restored
+func (s *amqpSubscription) pump() {
+	defer close(s.deliveries)
+	defer s.cancel()
+	defer s.broker.forget(s)
+
+	var msgs <-chan amqp.Delivery
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-s.lost:
+			return
+		case msgs = <-s.feeds:
+		case msg, ok := <-msgs:
+			if !ok {
+				msgs = nil
+				continue
+			}
+			select {
+			case s.deliveries <- Delivery{RoutingKey: msg.RoutingKey, Type: msg.Type, Body: msg.Body, ack: ack(msg)}:
+			case <-s.ctx.Done():
+				return
+			case <-s.lost:
+				return
+			}
+		}
+	}
+}
+
+// ack of delivery whose channel is gone is a no-op, the delivery is gone with it
+func ack(msg amqp.Delivery) func() error {
+	return func() error {
+		err := msg.Ack(false)
+		if errors.Is(err, amqp.ErrClosed) {
+			return nil
+		}
+		return err
+	}
+}
+
+func (s *amqpSubscription) Deliveries() <-chan Delivery {
+	return s.deliveries
+}
+
+// Bind adds key to the subscription, while recovering it's bound once channel is back
+func (s *amqpSubscription) Bind(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keys[key] = true
+	if s.ch == nil {
+		return nil
+	}
+	err := s.ch.QueueBind(
+		s.queue,        // queue name
+		key,            // routing key
+		s.broker.title, // exchange
+		false,
+		nil)
+	if errors.Is(err, amqp.ErrClosed) {
+		return nil
+	}
+	if err != nil {
+		s.broker.log.Warn("Failed to bind queue", zap.String("key", key))
+	}
+	return err
+}
+
+func (s *amqpSubscription) Unbind(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.keys, key)
+	if s.ch == nil {
+		return nil
+	}
+	err := s.ch.QueueUnbind(s.queue, key, s.broker.title, nil)
+	if errors.Is(err, amqp.ErrClosed) {
+		return nil
+	}
+	if err != nil {
+		s.broker.log.Warn("Failed to unbind queue", zap.String("key", key))
+	}
+	return err
+}
@@ -0,0 +1,199 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/streadway/amqp"
+	"go.uber.org/zap"
+)
+
+// fakeChannel records publishings, Publish blocks while block is open
+type fakeChannel struct {
+	inflight  int32
+	overlaps  int32
+	published int32
+
+	started chan struct{}
+	block   chan struct{}
+	err     error
+}
+
+func (c *fakeChannel) Publish(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+	if atomic.AddInt32(&c.inflight, 1) > 1 {
+		atomic.AddInt32(&c.overlaps, 1)
+	}
+	defer atomic.AddInt32(&c.inflight, -1)
+
+	if c.started != nil {
+		c.started <- struct{}{}
+	}
+	if c.block != nil {
+		<-c.block
+	}
+	// Gives overlapping calls a chance to show up
+	time.Sleep(time.Microsecond)
+
+	atomic.AddInt32(&c.published, 1)
+	return c.err
+}
+
+func (c *fakeChannel) NotifyClose(ch chan *amqp.Error) chan *amqp.Error {
+	return ch
+}
+
+func (c *fakeChannel) Close() error {
+	return nil
+}
+
+// newTestBroker runs the publisher goroutine on the fake channel, without connection
+func newTestBroker(t *testing.T, pub amqpChannel, queue int) *AMQPBroker {
+	b := &AMQPBroker{
+		log:       zap.NewNop(),
+		title:     ChatExchange,
+		pub:       pub,
+		subs:      make(map[*amqpSubscription]struct{}),
+		publishes: make(chan publishing, queue),
+		done:      make(chan struct{}),
+	}
+	go b.publisher()
+	t.Cleanup(func() { close(b.done) })
+	return b
+}
+
+func TestAMQPSinglePublisher(t *testing.T) {
+	pub := &fakeChannel{}
+	b := newTestBroker(t, pub, PublishQueueSize)
+
+	const publishers, events = 16, 50
+	var wg sync.WaitGroup
+	for i := 0; i < publishers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < events; j++ {
+				if err := b.Publish(context.Background(), "chat", "MESSAGE_CREATED", nil); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&pub.published); n != publishers*events {
+		t.Fatalf("published %d events, expected %d", n, publishers*events)
+	}
+	if n := atomic.LoadInt32(&pub.overlaps); n != 0 {
+		t.Fatalf("channel has been used concurrently %d times", n)
+	}
+}
+
+func TestAMQPPublishQueueFull(t *testing.T) {
+	pub := &fakeChannel{started: make(chan struct{}, 2), block: make(chan struct{})}
+	b := newTestBroker(t, pub, 1)
+
+	results := make(chan error, 2)
+	go func() { results <- b.Publish(context.Background(), "chat", "MESSAGE_CREATED", nil) }()
+	// Publisher is stuck on the first event, the second one fills the queue up
+	<-pub.started
+	go func() { results <- b.Publish(context.Background(), "chat", "MESSAGE_CREATED", nil) }()
+	for len(b.publishes) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	start := time.Now()
+	if err := b.Publish(context.Background(), "chat", "MESSAGE_CREATED", nil); !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("expected ErrUnavailable, got %v", err)
+	}
+	if time.Since(start) > testTimeout {
+		t.Fatal("publishing to the full queue has blocked")
+	}
+
+	close(pub.block)
+	for i := 0; i < 2; i++ {
+		select {
+		case err := <-results:
+			if err != nil {
+				t.Fatal(err)
+			}
+		case <-time.After(testTimeout):
+			t.Fatal("queued event hasn't been published")
+		}
+	}
+}
+
+func TestAMQPPublishUnavailable(t *testing.T) {
+	b := newTestBroker(t, &fakeChannel{err: amqp.ErrClosed}, 1)
+	if err := b.Publish(context.Background(), "chat", "MESSAGE_CREATED", nil); !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("closed channel: expected ErrUnavailable, got %v", err)
+	}
+
+	b.mu.Lock()
+	b.pub = nil
+	b.mu.Unlock()
+	if err := b.Publish(context.Background(), "chat", "MESSAGE_CREATED", nil); !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("recovering channel: expected ErrUnavailable, got %v", err)
+	}
+}
+
+func TestAMQPPublishTimeout(t *testing.T) {
+	pub := &fakeChannel{started: make(chan struct{}, 2), block: make(chan struct{})}
+	b := newTestBroker(t, pub, 1)
+	defer close(pub.block)
+
+	timeout := PublishTimeout
+	PublishTimeout = 10 * time.Millisecond
+	defer func() { PublishTimeout = timeout }()
+
+	// Channel is blocked, e.g. by flow control
+	start := time.Now()
+	if err := b.Publish(context.Background(), "chat", "MESSAGE_CREATED", nil); !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("expected ErrUnavailable, got %v", err)
+	}
+	if time.Since(start) > testTimeout {
+		t.Fatal("publish hasn't given up on deadline")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := b.PublishTransient(ctx, "chat", "TYPING", nil, time.Second); !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("cancelled request: expected ErrUnavailable, got %v", err)
+	}
+}
+
+func TestAMQPLostSubscriptionEnds(t *testing.T) {
+	b := newTestBroker(t, &fakeChannel{}, 1)
+	sub := &amqpSubscription{
+		broker:     b,
+		ctx:        context.Background(),
+		keys:       map[string]bool{},
+		feeds:      make(chan (<-chan amqp.Delivery), 1),
+		deliveries: make(chan Delivery),
+		lost:       make(chan struct{}),
+	}
+	b.subs[sub] = struct{}{}
+	go sub.pump()
+
+	// Restore fails after reconnect
+	sub.lose()
+	sub.lose()
+
+	select {
+	case _, ok := <-sub.Deliveries():
+		if ok {
+			t.Fatal("unexpected delivery")
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("deliveries of the lost subscription not closed")
+	}
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if len(b.subs) != 0 {
+		t.Fatal("lost subscription is still restored by broker")
+	}
+}
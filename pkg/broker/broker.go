@@ -0,0 +1,56 @@
+package broker
+
+import (
+	"context"
+	"time"
+)
+
+const ChatExchange = "chats"
+
+// Broker fans out chat events to subscribers by routing keys, chat uuids and account keys
+type Broker interface {
+	// Publish sends event of the given type to key subscribers, it gives up once the context is done
+	Publish(ctx context.Context, key, event string, body []byte) error
+	// PublishTransient sends event which is dropped unless delivered within ttl
+	PublishTransient(ctx context.Context, key, event string, body []byte, ttl time.Duration) error
+	// PublishConfirmed sends event and waits until broker confirms it's accepted
+	PublishConfirmed(ctx context.Context, key, event string, body []byte) error
+	// Subscribe to the given keys, subscription ends together with the context
+	Subscribe(ctx context.Context, keys ...string) (Subscription, error)
+}
+
+// Broker implementations selectable through config
+const (
+	AMQP   = "amqp"
+	MEMORY = "memory"
+)
+
+// Subscription receives events published to the keys it's bound to
+type Subscription interface {
+	// Deliveries is closed once subscription ends, with the context or
+	// because it's lost together with the broker connection
+	Deliveries() <-chan Delivery
+	Bind(key string) error
+	Unbind(key string) error
+}
+
+type Delivery struct {
+	RoutingKey string
+	Type       string
+	Body       []byte
+
+	ack func() error
+}
+
+// Ack confirms delivery is handled, unacked deliveries count towards the prefetch limit
+func (d Delivery) Ack() error {
+	if d.ack == nil {
+		return nil
+	}
+	return d.ack()
+}
+
+// Routing key for events addressed to the account rather than a chat
+func AccountKey(account string) string {
+	return "account." + account
+}
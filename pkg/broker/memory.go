@@ -0,0 +1,125 @@
+package broker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Events buffered per subscriber, events to subscribers lagging behind further are dropped
+const MemoryBufferSize = 256
+
+// MemoryBroker fans out events within the process, for single-node deployments and tests
+type MemoryBroker struct {
+	log  *zap.Logger
+	mu   sync.RWMutex
+	subs map[*memorySubscription]struct{}
+}
+
+func NewMemoryBroker(logger *zap.Logger) *MemoryBroker {
+	return &MemoryBroker{
+		log:  logger.Named("MemoryBroker"),
+		subs: make(map[*memorySubscription]struct{}),
+	}
+}
+
+// ErrDropped is returned by PublishConfirmed if any subscriber was lagging behind and didn't get the event
+var ErrDropped = status.Error(codes.ResourceExhausted, "Event dropped by lagging subscriber")
+
+// Publish is best-effort, events to lagging subscribers are dropped
+func (b *MemoryBroker) Publish(ctx context.Context, key, event string, body []byte) error {
+	b.deliver(key, event, body)
+	return nil
+}
+
+// deliver hands event to the key subscribers, returns whether any of them dropped it
+func (b *MemoryBroker) deliver(key, event string, body []byte) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	dropped := false
+	for sub := range b.subs {
+		if !sub.bound(key) {
+			continue
+		}
+		select {
+		case sub.deliveries <- Delivery{RoutingKey: key, Type: event, Body: body}:
+		default:
+			b.log.Warn("Subscriber is lagging behind, dropping event", zap.String("key", key), zap.String("type", event))
+			dropped = true
+		}
+	}
+	return dropped
+}
+
+// PublishTransient delivers event right away, so there is nothing to expire
+func (b *MemoryBroker) PublishTransient(ctx context.Context, key, event string, body []byte, ttl time.Duration) error {
+	return b.Publish(ctx, key, event, body)
+}
+
+// PublishConfirmed hands event to subscribers before it returns, fails with ErrDropped
+// if any of them didn't get it, so the event is published again
+func (b *MemoryBroker) PublishConfirmed(ctx context.Context, key, event string, body []byte) error {
+	if b.deliver(key, event, body) {
+		return ErrDropped
+	}
+	return nil
+}
+
+type memorySubscription struct {
+	mu         sync.RWMutex
+	keys       map[string]bool
+	deliveries chan Delivery
+}
+
+func (b *MemoryBroker) Subscribe(ctx context.Context, keys ...string) (Subscription, error) {
+	sub := &memorySubscription{
+		keys:       make(map[string]bool, len(keys)),
+		deliveries: make(chan Delivery, MemoryBufferSize),
+	}
+	for _, key := range keys {
+		sub.keys[key] = true
+	}
+
+	b.mu.Lock()
+	b.subs[sub] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		delete(b.subs, sub)
+		b.mu.Unlock()
+		close(sub.deliveries)
+	}()
+
+	return sub, nil
+}
+
+func (s *memorySubscription) bound(key string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.keys[key]
+}
+
+func (s *memorySubscription) Deliveries() <-chan Delivery {
+	return s.deliveries
+}
+
+func (s *memorySubscription) Bind(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys[key] = true
+	return nil
+}
+
+func (s *memorySubscription) Unbind(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.keys, key)
+	return nil
+}
@@ -0,0 +1,202 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const testTimeout = time.Second
+
+func receive(t *testing.T, sub Subscription) Delivery {
+	t.Helper()
+	select {
+	case msg, ok := <-sub.Deliveries():
+		if !ok {
+			t.Fatal("deliveries closed")
+		}
+		return msg
+	case <-time.After(testTimeout):
+		t.Fatal("no delivery")
+	}
+	return Delivery{}
+}
+
+func expectNone(t *testing.T, sub Subscription) {
+	t.Helper()
+	select {
+	case msg := <-sub.Deliveries():
+		t.Fatalf("unexpected delivery to %s", msg.RoutingKey)
+	default:
+	}
+}
+
+func TestMemoryPublishSubscribe(t *testing.T) {
+	b := NewMemoryBroker(zap.NewNop())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sub, err := b.Subscribe(ctx, "chat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := b.Subscribe(ctx, "other")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := b.Publish(context.Background(), "chat", "MESSAGE_CREATED", []byte("body")); err != nil {
+		t.Fatal(err)
+	}
+	msg := receive(t, sub)
+	if msg.RoutingKey != "chat" || msg.Type != "MESSAGE_CREATED" || string(msg.Body) != "body" {
+		t.Fatalf("unexpected delivery %+v", msg)
+	}
+	if err := msg.Ack(); err != nil {
+		t.Fatal(err)
+	}
+	expectNone(t, other)
+}
+
+func TestMemoryBindUnbind(t *testing.T) {
+	b := NewMemoryBroker(zap.NewNop())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sub, err := b.Subscribe(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_ = b.Publish(context.Background(), "chat", "TYPING", nil)
+	expectNone(t, sub)
+
+	if err := sub.Bind("chat"); err != nil {
+		t.Fatal(err)
+	}
+	_ = b.Publish(context.Background(), "chat", "TYPING", nil)
+	receive(t, sub)
+
+	if err := sub.Unbind("chat"); err != nil {
+		t.Fatal(err)
+	}
+	_ = b.Publish(context.Background(), "chat", "TYPING", nil)
+	expectNone(t, sub)
+}
+
+func TestMemorySubscriptionEndsWithContext(t *testing.T) {
+	b := NewMemoryBroker(zap.NewNop())
+	ctx, cancel := context.WithCancel(context.Background())
+
+	sub, err := b.Subscribe(ctx, "chat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+
+	select {
+	case _, ok := <-sub.Deliveries():
+		if ok {
+			t.Fatal("unexpected delivery")
+		}
+	case <-time.After(testTimeout):
+		t.Fatal("deliveries not closed")
+	}
+	if err := b.PublishConfirmed(context.Background(), "chat", "TYPING", nil); err != nil {
+		t.Fatalf("publishing without subscribers: %v", err)
+	}
+}
+
+func TestMemoryPublishConfirmedDropped(t *testing.T) {
+	b := NewMemoryBroker(zap.NewNop())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sub, err := b.Subscribe(ctx, "chat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < MemoryBufferSize; i++ {
+		if err := b.PublishConfirmed(ctx, "chat", "MESSAGE_CREATED", nil); err != nil {
+			t.Fatalf("event %d: %v", i, err)
+		}
+	}
+
+	if err := b.PublishConfirmed(ctx, "chat", "MESSAGE_CREATED", nil); !errors.Is(err, ErrDropped) {
+		t.Fatalf("expected ErrDropped, got %v", err)
+	}
+	if err := b.Publish(context.Background(), "chat", "MESSAGE_CREATED", nil); err != nil {
+		t.Fatalf("Publish is best-effort, got %v", err)
+	}
+
+	receive(t, sub)
+	if err := b.PublishConfirmed(ctx, "chat", "MESSAGE_CREATED", nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMemoryConcurrentUse(t *testing.T) {
+	b := NewMemoryBroker(zap.NewNop())
+	keys := []string{"a", "b", "c"}
+
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				for _, key := range keys {
+					_ = b.Publish(context.Background(), key, "MESSAGE_CREATED", nil)
+					_ = b.PublishConfirmed(context.Background(), key, "MESSAGE_CREATED", nil)
+				}
+			}
+		}()
+	}
+
+	var subscribers sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		subscribers.Add(1)
+		go func(i int) {
+			defer subscribers.Done()
+			ctx, cancel := context.WithCancel(context.Background())
+			sub, err := b.Subscribe(ctx, keys[i%len(keys)])
+			if err != nil {
+				cancel()
+				t.Error(err)
+				return
+			}
+			_ = sub.Bind(keys[(i+1)%len(keys)])
+			for j := 0; j < 10; j++ {
+				msg, ok := <-sub.Deliveries()
+				if !ok {
+					break
+				}
+				_ = msg.Ack()
+			}
+			_ = sub.Unbind(keys[i%len(keys)])
+			cancel()
+			// Deliveries are closed once subscription is gone
+			for range sub.Deliveries() {
+			}
+		}(i)
+	}
+	subscribers.Wait()
+	close(stop)
+	wg.Wait()
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if len(b.subs) != 0 {
+		t.Fatalf("%d subscriptions left after cancel", len(b.subs))
+	}
+}
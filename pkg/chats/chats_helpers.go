@@ -1,15 +1,127 @@
 package chats
 
 import (
+	"context"
+	"time"
+
+	pb "github.com/slntopp/nocloud-cc/cc"
 	"github.com/slntopp/nocloud-cc/pkg/broker"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
-var queues map[string]broker.MsgPub = make(map[string]broker.MsgPub)
+func chatEvent(chat string, event *pb.ChatEvent) ([]byte, error) {
+	event.ChatUuid = chat
+	event.At = time.Now().UnixMilli()
+	return protojson.Marshal(event)
+}
+
+// PublishChatEvent sends event to the chat subscribers and to given accounts account-wide streams,
+// event type goes to the AMQP message type
+func (s *ChatsServiceServer) PublishChatEvent(ctx context.Context, chat string, event *pb.ChatEvent, accounts ...string) error {
+	body, err := chatEvent(chat, event)
+	if err != nil {
+		return err
+	}
+	if err := s.broker.Publish(ctx, chat, event.GetType().String(), body); err != nil {
+		return err
+	}
+	for _, account := range accounts {
+		if err := s.broker.Publish(ctx, broker.AccountKey(account), event.GetType().String(), body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ChatsServiceServer) PublishMessage(ctx context.Context, t pb.ChatEvent_Type, msg *pb.ChatMessage) error {
+	return s.PublishChatEvent(ctx, msg.GetTo(), &pb.ChatEvent{
+		Type:    t,
+		Payload: &pb.ChatEvent_Message{Message: msg},
+	})
+}
+
+func (s *ChatsServiceServer) PublishChat(ctx context.Context, t pb.ChatEvent_Type, chat *pb.Chat) error {
+	return s.PublishChatEvent(ctx, chat.GetUuid(), &pb.ChatEvent{
+		Type:    t,
+		Payload: &pb.ChatEvent_Chat{Chat: chat},
+	})
+}
+
+// PublishMember sends member event, joined and left members get it to their account-wide streams too
+func (s *ChatsServiceServer) PublishMember(ctx context.Context, t pb.ChatEvent_Type, chat string, member *pb.ChatMember) error {
+	var accounts []string
+	if t == pb.ChatEvent_MEMBER_JOINED || t == pb.ChatEvent_MEMBER_LEFT {
+		accounts = append(accounts, member.GetUuid())
+	}
+	return s.PublishChatEvent(ctx, chat, &pb.ChatEvent{
+		Type:    t,
+		Payload: &pb.ChatEvent_Member{Member: member},
+	}, accounts...)
+}
+
+func (s *ChatsServiceServer) PublishInvite(ctx context.Context, invite *pb.ChatInvite) error {
+	return s.PublishChatEvent(ctx, invite.GetChat(), &pb.ChatEvent{
+		Type:    pb.ChatEvent_MEMBER_INVITED,
+		Payload: &pb.ChatEvent_Invite{Invite: invite},
+	}, invite.GetTo())
+}
+
+func (s *ChatsServiceServer) PublishReadReceipt(ctx context.Context, receipt *pb.ReadReceipt) error {
+	return s.PublishChatEvent(ctx, receipt.GetChat(), &pb.ChatEvent{
+		Type:    pb.ChatEvent_READ_RECEIPT,
+		Payload: &pb.ChatEvent_Receipt{Receipt: receipt},
+	})
+}
+
+// PublishTyping sends typing event, it isn't persisted and expires in TypingTTL
+func (s *ChatsServiceServer) PublishTyping(ctx context.Context, typing *pb.TypingEvent) error {
+	body, err := chatEvent(typing.GetChat(), &pb.ChatEvent{
+		Type:    pb.ChatEvent_TYPING,
+		Payload: &pb.ChatEvent_Typing{Typing: typing},
+	})
+	if err != nil {
+		return err
+	}
+	return s.broker.PublishTransient(ctx, typing.GetChat(), pb.ChatEvent_TYPING.String(), body, TypingTTL)
+}
+
+// subscriptionEnded tells why deliveries have ended, ending before the stream did means
+// broker has lost the subscription, so client has to reconnect and replay
+func subscriptionEnded(ctx context.Context) error {
+	if ctx.Err() != nil {
+		return nil
+	}
+	return status.Error(codes.Unavailable, "Subscription is lost, reconnect to resume")
+}
+
+// ack marks delivery handled
+func (s *ChatsServiceServer) ack(msg broker.Delivery) {
+	if err := msg.Ack(); err != nil {
+		s.log.Warn("Failed to ack delivery", zap.String("key", msg.RoutingKey), zap.Error(err))
+	}
+}
+
+var unmarshalOpts = protojson.UnmarshalOptions{DiscardUnknown: true}
 
-func GetChatPub(uuid string) broker.MsgPub {
-	if _, ok := queues[uuid]; !ok {
-		queues[uuid] = broker.GetPublisher(uuid)
+// DecodeChatEvent reads event published with PublishChatEvent,
+// untyped bodies are bare messages published by older instances
+func DecodeChatEvent(event string, body []byte) (*pb.ChatEvent, error) {
+	if event == "" {
+		msg := &pb.ChatMessage{}
+		if err := unmarshalOpts.Unmarshal(body, msg); err != nil {
+			return nil, err
+		}
+		return &pb.ChatEvent{
+			Type:     pb.ChatEvent_MESSAGE_CREATED,
+			Payload:  &pb.ChatEvent_Message{Message: msg},
+			ChatUuid: msg.GetTo(),
+		}, nil
 	}
 
-	return queues[uuid]
+	e := &pb.ChatEvent{}
+	err := unmarshalOpts.Unmarshal(body, e)
+	return e, err
 }
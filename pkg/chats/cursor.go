@@ -0,0 +1,77 @@
+package chats
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	pb "github.com/slntopp/nocloud-cc/cc"
+)
+
+// Messages sent within ReplayWindow before the StreamAll cursor are replayed again on resume.
+// sent_at is taken before the message is committed and relayed, so across chats messages
+// aren't delivered in sent_at order, the window covers messages committed or relayed late
+var ReplayWindow = time.Minute
+
+// streamCursor is StreamAll resumption point: the latest sent_at delivered and
+// uuids of the messages delivered within ReplayWindow before it, so they aren't sent twice
+type streamCursor struct {
+	At   int64    `json:"at"`
+	Seen []string `json:"seen,omitempty"`
+}
+
+func decodeStreamCursor(token string) (*streamCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+	cursor := &streamCursor{}
+	err = json.Unmarshal(data, cursor)
+	return cursor, err
+}
+
+// deliveredWindow tracks messages delivered by StreamAll within ReplayWindow
+type deliveredWindow struct {
+	at   int64
+	seen map[string]int64
+}
+
+func newDeliveredWindow(cursor *streamCursor) *deliveredWindow {
+	w := &deliveredWindow{seen: make(map[string]int64)}
+	if cursor != nil {
+		w.at = cursor.At
+		for _, uuid := range cursor.Seen {
+			w.seen[uuid] = cursor.At
+		}
+	}
+	return w
+}
+
+// from is sent_at replay starts after
+func (w *deliveredWindow) from() int64 {
+	return w.at - ReplayWindow.Milliseconds()
+}
+
+func (w *deliveredWindow) delivered(msg *pb.ChatMessage) bool {
+	_, ok := w.seen[msg.GetUuid()]
+	return ok
+}
+
+// add records message delivered and returns cursor to resume after it
+func (w *deliveredWindow) add(msg *pb.ChatMessage) string {
+	w.seen[msg.GetUuid()] = msg.GetSentAt()
+	if msg.GetSentAt() > w.at {
+		w.at = msg.GetSentAt()
+	}
+
+	cursor := &streamCursor{At: w.at}
+	for uuid, at := range w.seen {
+		if at < w.from() {
+			delete(w.seen, uuid)
+			continue
+		}
+		cursor.Seen = append(cursor.Seen, uuid)
+	}
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
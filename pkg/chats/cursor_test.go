@@ -0,0 +1,37 @@
+package chats
+
+import (
+	"testing"
+
+	pb "github.com/slntopp/nocloud-cc/cc"
+)
+
+func TestDeliveredWindowResume(t *testing.T) {
+	window := newDeliveredWindow(nil)
+	window.add(&pb.ChatMessage{Uuid: "old", SentAt: 1000})
+	token := window.add(&pb.ChatMessage{Uuid: "late", SentAt: 1000 + ReplayWindow.Milliseconds()})
+	token = window.add(&pb.ChatMessage{Uuid: "latest", SentAt: 2000 + ReplayWindow.Milliseconds()})
+	// Committed after the latest one was delivered, though sent before it
+	committedLate := &pb.ChatMessage{Uuid: "committed-late", SentAt: 1500 + ReplayWindow.Milliseconds()}
+
+	cursor, err := decodeStreamCursor(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resumed := newDeliveredWindow(cursor)
+
+	if from := resumed.from(); from >= committedLate.GetSentAt() {
+		t.Fatalf("replay starts after the message committed late: %d", from)
+	}
+	if resumed.delivered(committedLate) {
+		t.Fatal("message committed late is taken as delivered")
+	}
+	for _, uuid := range []string{"late", "latest"} {
+		if !resumed.delivered(&pb.ChatMessage{Uuid: uuid}) {
+			t.Fatalf("message %s within the window is replayed again", uuid)
+		}
+	}
+	if resumed.delivered(&pb.ChatMessage{Uuid: "old"}) {
+		t.Fatal("message out of the window is kept in cursor")
+	}
+}
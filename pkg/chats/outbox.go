@@ -0,0 +1,104 @@
+package chats
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/slntopp/nocloud-cc/cc"
+	"go.uber.org/zap"
+)
+
+// Records claimed by the relay at once, and time they stay leased to it
+const (
+	OutboxBatchSize = 100
+	OutboxLease     = 30 * time.Second
+)
+
+// Time to wait for broker to confirm single record
+var ConfirmTimeout = 10 * time.Second
+
+var errOutboxBlocked = errors.New("earlier record of the chat is not published")
+
+// wakeOutbox makes relay publish new records right away instead of waiting for the next tick
+func (s *ChatsServiceServer) wakeOutbox() {
+	select {
+	case s.outboxWake <- struct{}{}:
+	default:
+	}
+}
+
+// OutboxRelay publishes pending outbox records with broker confirms, failed ones are retried until confirmed
+func (s *ChatsServiceServer) OutboxRelay(ctx context.Context, frequency time.Duration) {
+	log := s.log.Named("OutboxRelay")
+	log.Info("Starting", zap.Duration("frequency", frequency))
+
+	ticker := time.NewTicker(frequency)
+	defer ticker.Stop()
+	for {
+		// Backlog is drained batch by batch while they are published in full
+		for s.relayOutbox(ctx, log) == OutboxBatchSize {
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-s.outboxWake:
+		}
+	}
+}
+
+// relayOutbox publishes one batch of records, returns number of confirmed ones
+func (s *ChatsServiceServer) relayOutbox(ctx context.Context, log *zap.Logger) int {
+	records, err := s.outbox_ctrl.Claim(ctx, OutboxBatchSize, OutboxLease)
+	if err != nil {
+		log.Error("Failed to claim outbox records", zap.Error(err))
+		return 0
+	}
+
+	published := 0
+	// Records are published in order per chat, once one fails the later ones of the chat wait for it
+	failed := make(map[string]bool)
+	for _, record := range records {
+		if failed[record.GetRoutingKey()] {
+			if err := s.outbox_ctrl.MarkFailed(ctx, record.GetUuid(), errOutboxBlocked); err != nil {
+				log.Warn("Failed to release outbox record", zap.String("uuid", record.GetUuid()), zap.Error(err))
+			}
+			continue
+		}
+
+		pubCtx, cancel := context.WithTimeout(ctx, ConfirmTimeout)
+		err := s.broker.PublishConfirmed(pubCtx, record.GetRoutingKey(), record.GetType(), []byte(record.GetBody()))
+		cancel()
+
+		if err != nil {
+			log.Warn("Failed to publish outbox record", zap.String("uuid", record.GetUuid()), zap.Int32("attempts", record.GetAttempts()), zap.Error(err))
+			failed[record.GetRoutingKey()] = true
+			if err := s.outbox_ctrl.MarkFailed(ctx, record.GetUuid(), err); err != nil {
+				log.Warn("Failed to release outbox record", zap.String("uuid", record.GetUuid()), zap.Error(err))
+			}
+			continue
+		}
+		if err := s.outbox_ctrl.MarkPublished(ctx, record.GetUuid()); err != nil {
+			log.Warn("Failed to mark outbox record published", zap.String("uuid", record.GetUuid()), zap.Error(err))
+		}
+		published++
+	}
+	return published
+}
+
+func (s *ChatsServiceServer) ListOutbox(ctx context.Context, req *pb.ListOutboxRequest) (*pb.ListOutboxResponse, error) {
+	s.log.Info("Got ListOutbox Request", zap.Any("request", req))
+	return s.outbox_ctrl.List(ctx, req)
+}
+
+func (s *ChatsServiceServer) ReplayOutbox(ctx context.Context, req *pb.ReplayOutboxRequest) (*pb.ReplayOutboxResponse, error) {
+	s.log.Info("Got ReplayOutbox Request", zap.Any("request", req))
+	count, err := s.outbox_ctrl.Replay(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	s.wakeOutbox()
+	return &pb.ReplayOutboxResponse{Count: count}, nil
+}
@@ -0,0 +1,40 @@
+package chats
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// PurgeRoutine hard-deletes Chats and messages deleted, and outbox records published more than retention ago
+func (s *ChatsServiceServer) PurgeRoutine(ctx context.Context, retention, frequency time.Duration) {
+	log := s.log.Named("PurgeRoutine")
+	log.Info("Starting", zap.Duration("retention", retention), zap.Duration("frequency", frequency))
+
+	ticker := time.NewTicker(frequency)
+	defer ticker.Stop()
+	tick := time.Now()
+	for {
+		cutoff := tick.Add(-retention).UnixMilli()
+		log.Info("Purging deleted chats and messages", zap.Time("tick", tick), zap.Int64("cutoff", cutoff))
+
+		chats, err := s.cht_ctrl.PurgeDeleted(ctx, cutoff)
+		if err != nil {
+			log.Error("Error purging chats", zap.Error(err))
+		}
+		if err := s.msg_ctrl.PurgeDeleted(ctx, cutoff); err != nil {
+			log.Error("Error purging messages", zap.Error(err))
+		}
+		if err := s.outbox_ctrl.PurgePublished(ctx, cutoff); err != nil {
+			log.Error("Error purging outbox", zap.Error(err))
+		}
+		log.Info("Purged", zap.Int("chats", chats))
+
+		select {
+		case <-ctx.Done():
+			return
+		case tick = <-ticker.C:
+		}
+	}
+}
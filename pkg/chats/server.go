@@ -2,13 +2,13 @@ package chats
 
 import (
 	"context"
-	"encoding/json"
+	"time"
 
 	"github.com/arangodb/go-driver"
+	pb "github.com/slntopp/nocloud-cc/cc"
 	"github.com/slntopp/nocloud-cc/pkg/broker"
 	"github.com/slntopp/nocloud-cc/pkg/graph"
-	"github.com/slntopp/nocloud-cc/pkg/schema"
-	pb "github.com/slntopp/nocloud-proto/cc"
+	"github.com/slntopp/nocloud/pkg/nocloud"
 	"github.com/slntopp/nocloud/pkg/nocloud/access"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -21,17 +21,27 @@ type ChatsServiceServer struct {
 	cht_ctrl graph.ChatsController
 	msg_ctrl graph.ChatsMessagesController
 	log      *zap.Logger
+	typing   *typingThrottle
+	broker   broker.Broker
+
+	outbox_ctrl graph.OutboxController
+	outboxWake  chan struct{}
 }
 
-func NewChatsServer(log *zap.Logger, db driver.Database) *ChatsServiceServer {
+func NewChatsServer(log *zap.Logger, db driver.Database, b broker.Broker) *ChatsServiceServer {
 	logger := log.Named("ChatServer")
 	chatsController := graph.NewChatsController(logger, db)
-	messagesController := graph.NewChatsMessagesController(logger, db)
+	messagesController := graph.NewChatsMessagesController(logger, db, chatsController)
 	return &ChatsServiceServer{
 		db:       db,
 		log:      logger,
 		cht_ctrl: chatsController,
 		msg_ctrl: messagesController,
+		typing:   newTypingThrottle(),
+		broker:   b,
+
+		outbox_ctrl: graph.NewOutboxController(logger, db),
+		outboxWake:  make(chan struct{}, 1),
 	}
 }
 
@@ -44,31 +54,81 @@ func (s *ChatsServiceServer) GetChat(ctx context.Context, req *pb.GetChatRequest
 	return chat.Chat, nil
 }
 
+func (s *ChatsServiceServer) ListChats(ctx context.Context, req *pb.ListChatsRequest) (*pb.ListChatsResponse, error) {
+	s.log.Info("Got ListChats Request", zap.Any("request", req))
+	res, err := s.cht_ctrl.List(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func (s *ChatsServiceServer) DeleteChat(ctx context.Context, req *pb.DeleteChatRequest) (*pb.Response, error) {
 	s.log.Info("Got DeleteChat Request", zap.Any("request", req))
 	err := s.cht_ctrl.Delete(ctx, req.GetUuid())
 	if err != nil {
 		return nil, err
 	}
+
+	if err := s.PublishChat(ctx, pb.ChatEvent_CHAT_DELETED, &pb.Chat{Uuid: req.GetUuid()}); err != nil {
+		s.log.Warn("Error while publishing chat deletion", zap.Error(err))
+	}
+
 	return &pb.Response{}, nil
 }
 
+func (s *ChatsServiceServer) RestoreChat(ctx context.Context, req *pb.RestoreChatRequest) (*pb.Chat, error) {
+	s.log.Info("Got RestoreChat Request", zap.Any("request", req))
+	chat, err := s.cht_ctrl.Restore(ctx, req.GetUuid())
+	if err != nil {
+		return nil, err
+	}
+	// Streams of the members have unbound the chat once it was deleted,
+	// event to their accounts makes them bind it again
+	var accounts []string
+	members, err := s.cht_ctrl.ListMembers(ctx, chat.GetUuid())
+	if err != nil {
+		s.log.Warn("Error while fetching members", zap.Error(err))
+	}
+	for _, member := range members {
+		accounts = append(accounts, member.GetUuid())
+	}
+	if err := s.PublishChatEvent(ctx, chat.GetUuid(), &pb.ChatEvent{
+		Type:    pb.ChatEvent_CHAT_UPDATED,
+		Payload: &pb.ChatEvent_Chat{Chat: chat.Chat},
+	}, accounts...); err != nil {
+		s.log.Warn("Error while publishing chat", zap.Error(err))
+	}
+	return chat.Chat, nil
+}
+
 func (s *ChatsServiceServer) CreateChat(ctx context.Context, req *pb.CreateChatRequest) (*pb.Chat, error) {
 	s.log.Info("Got CreateChat Request", zap.Any("request", req))
 	chat, err := s.cht_ctrl.Create(ctx, req.Chat)
 	if err != nil {
 		return nil, err
 	}
+	// Owner is the only member Create adds, its streams bind the chat on this event
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
+	s.publishMember(ctx, pb.ChatEvent_MEMBER_JOINED, chat.GetUuid(), requestor)
 	return chat.Chat, nil
 }
 
-func (s *ChatsServiceServer) Update(ctx context.Context, chat *pb.Chat) (*pb.Chat, error) {
-	s.log.Info("Got UpdateChat Request", zap.Any("request", chat))
-	err := s.cht_ctrl.Update(ctx, chat)
+// UpdateChat updates every mutable field, kept for clients predating PatchChat
+func (s *ChatsServiceServer) UpdateChat(ctx context.Context, req *pb.Chat) (*pb.Chat, error) {
+	return s.PatchChat(ctx, &pb.UpdateChatRequest{Chat: req})
+}
+
+func (s *ChatsServiceServer) PatchChat(ctx context.Context, req *pb.UpdateChatRequest) (*pb.Chat, error) {
+	s.log.Info("Got PatchChat Request", zap.Any("request", req))
+	chat, err := s.cht_ctrl.Update(ctx, req.GetChat(), req.GetUpdateMask())
 	if err != nil {
 		return nil, err
 	}
-	return chat, nil
+	if err := s.PublishChat(ctx, pb.ChatEvent_CHAT_UPDATED, chat.Chat); err != nil {
+		s.log.Warn("Error while publishing chat", zap.Error(err))
+	}
+	return chat.Chat, nil
 }
 
 func (s *ChatsServiceServer) SendChatMessage(ctx context.Context, req *pb.SendChatMessageRequest) (*pb.ChatMessage, error) {
@@ -77,9 +137,8 @@ func (s *ChatsServiceServer) SendChatMessage(ctx context.Context, req *pb.SendCh
 	if err != nil {
 		return nil, err
 	}
-	if err := GetChatPub(msg.To)(msg.ChatMessage); err != nil {
-		s.log.Warn("Error while publishing message", zap.Error(err))
-	}
+	// Message event, and thread parent one carrying updated replies count, are published from the outbox
+	s.wakeOutbox()
 
 	return msg.ChatMessage, nil
 }
@@ -95,59 +154,507 @@ func (s *ChatsServiceServer) GetChatMessage(ctx context.Context, req *pb.GetChat
 
 func (s *ChatsServiceServer) DeleteChatMessage(ctx context.Context, req *pb.DeleteChatMessageRequest) (*pb.Response, error) {
 	s.log.Info("Got DeleteChatMessage Request", zap.Any("request", req))
-	err := s.msg_ctrl.Delete(ctx, req.GetUuid())
+	msg, err := s.msg_ctrl.Delete(ctx, req.GetUuid())
 	if err != nil {
 		return nil, err
 	}
+	if err := s.PublishMessage(ctx, pb.ChatEvent_MESSAGE_DELETED, msg.ChatMessage); err != nil {
+		s.log.Warn("Error while publishing message", zap.Error(err))
+	}
 	return &pb.Response{}, nil
 }
 
-func (s *ChatsServiceServer) UpdateChatMessage(ctx context.Context, msg *pb.ChatMessage) (*pb.ChatMessage, error) {
-	s.log.Info("Got UpdateChatMessage Request", zap.Any("request", msg))
-	err := s.msg_ctrl.Update(ctx, msg)
+func (s *ChatsServiceServer) RestoreChatMessage(ctx context.Context, req *pb.RestoreChatMessageRequest) (*pb.ChatMessage, error) {
+	s.log.Info("Got RestoreChatMessage Request", zap.Any("request", req))
+	msg, err := s.msg_ctrl.Restore(ctx, req.GetUuid())
 	if err != nil {
 		return nil, err
 	}
-	return msg, nil
+	if err := s.PublishMessage(ctx, pb.ChatEvent_MESSAGE_UPDATED, msg.ChatMessage); err != nil {
+		s.log.Warn("Error while publishing message", zap.Error(err))
+	}
+	return msg.ChatMessage, nil
+}
+
+// UpdateChatMessage updates every mutable field, kept for clients predating PatchChatMessage
+func (s *ChatsServiceServer) UpdateChatMessage(ctx context.Context, req *pb.ChatMessage) (*pb.ChatMessage, error) {
+	return s.PatchChatMessage(ctx, &pb.UpdateChatMessageRequest{Message: req})
+}
+
+func (s *ChatsServiceServer) PatchChatMessage(ctx context.Context, req *pb.UpdateChatMessageRequest) (*pb.ChatMessage, error) {
+	s.log.Info("Got PatchChatMessage Request", zap.Any("request", req))
+	updated, err := s.msg_ctrl.Update(ctx, req.GetMessage(), req.GetUpdateMask())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.PublishMessage(ctx, pb.ChatEvent_MESSAGE_UPDATED, updated.ChatMessage); err != nil {
+		s.log.Warn("Error while publishing message", zap.Error(err))
+	}
+	return updated.ChatMessage, nil
+}
+
+func (s *ChatsServiceServer) ListThread(ctx context.Context, req *pb.ListThreadRequest) (*pb.ListThreadResponse, error) {
+	s.log.Info("Got ListThread Request", zap.Any("request", req))
+	return s.msg_ctrl.ListThread(ctx, req)
+}
+
+func (s *ChatsServiceServer) AddReaction(ctx context.Context, req *pb.ReactionRequest) (*pb.ChatMessage, error) {
+	s.log.Info("Got AddReaction Request", zap.Any("request", req))
+	msg, err := s.msg_ctrl.AddReaction(ctx, req.GetUuid(), req.GetEmoji())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.PublishMessage(ctx, pb.ChatEvent_MESSAGE_UPDATED, msg.ChatMessage); err != nil {
+		s.log.Warn("Error while publishing message", zap.Error(err))
+	}
+	return msg.ChatMessage, nil
+}
+
+func (s *ChatsServiceServer) RemoveReaction(ctx context.Context, req *pb.ReactionRequest) (*pb.ChatMessage, error) {
+	s.log.Info("Got RemoveReaction Request", zap.Any("request", req))
+	msg, err := s.msg_ctrl.RemoveReaction(ctx, req.GetUuid(), req.GetEmoji())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.PublishMessage(ctx, pb.ChatEvent_MESSAGE_UPDATED, msg.ChatMessage); err != nil {
+		s.log.Warn("Error while publishing message", zap.Error(err))
+	}
+	return msg.ChatMessage, nil
+}
+
+func (s *ChatsServiceServer) ListMessageRevisions(ctx context.Context, req *pb.ListMessageRevisionsRequest) (*pb.ListMessageRevisionsResponse, error) {
+	s.log.Info("Got ListMessageRevisions Request", zap.Any("request", req))
+	revisions, err := s.msg_ctrl.ListRevisions(ctx, req.GetUuid())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListMessageRevisionsResponse{Revisions: revisions}, nil
 }
 
 func (s *ChatsServiceServer) Invite(ctx context.Context, req *pb.InviteChatRequest) (*pb.Response, error) {
 	s.log.Info("Got Invite Request", zap.Any("request", req))
-	err := s.cht_ctrl.InviteUser(ctx, req)
+	invite, err := s.cht_ctrl.InviteUser(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if invite != nil {
+		if err := s.PublishInvite(ctx, invite); err != nil {
+			s.log.Warn("Error while publishing invite", zap.Error(err))
+		}
+	}
+	return &pb.Response{}, nil
+}
+
+func (s *ChatsServiceServer) ListInvites(ctx context.Context, req *pb.ListInvitesRequest) (*pb.ListInvitesResponse, error) {
+	s.log.Info("Got ListInvites Request", zap.Any("request", req))
+	invites, err := s.cht_ctrl.ListInvites(ctx, req.GetChatUuid())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListInvitesResponse{Invites: invites}, nil
+}
+
+func (s *ChatsServiceServer) AcceptInvite(ctx context.Context, req *pb.ChatInviteRequest) (*pb.Response, error) {
+	s.log.Info("Got AcceptInvite Request", zap.Any("request", req))
+	invite, joined, err := s.cht_ctrl.AcceptInvite(ctx, req.GetUuid())
+	if err != nil {
+		return nil, err
+	}
+	if joined {
+		s.publishMember(ctx, pb.ChatEvent_MEMBER_JOINED, invite.GetChat(), invite.GetTo())
+	}
+	return &pb.Response{}, nil
+}
+
+func (s *ChatsServiceServer) DeclineInvite(ctx context.Context, req *pb.ChatInviteRequest) (*pb.Response, error) {
+	s.log.Info("Got DeclineInvite Request", zap.Any("request", req))
+	err := s.cht_ctrl.DeclineInvite(ctx, req.GetUuid())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Response{}, nil
+}
+
+func (s *ChatsServiceServer) RevokeInvite(ctx context.Context, req *pb.ChatInviteRequest) (*pb.Response, error) {
+	s.log.Info("Got RevokeInvite Request", zap.Any("request", req))
+	err := s.cht_ctrl.RevokeInvite(ctx, req.GetUuid())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Response{}, nil
+}
+
+func (s *ChatsServiceServer) CreateInviteLink(ctx context.Context, req *pb.CreateInviteLinkRequest) (*pb.ChatInviteLink, error) {
+	s.log.Info("Got CreateInviteLink Request", zap.String("chat", req.GetChatUuid()))
+	return s.cht_ctrl.CreateInviteLink(ctx, req)
+}
+
+func (s *ChatsServiceServer) ListInviteLinks(ctx context.Context, req *pb.ListInviteLinksRequest) (*pb.ListInviteLinksResponse, error) {
+	s.log.Info("Got ListInviteLinks Request", zap.Any("request", req))
+	links, err := s.cht_ctrl.ListInviteLinks(ctx, req.GetChatUuid())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListInviteLinksResponse{Links: links}, nil
+}
+
+func (s *ChatsServiceServer) RevokeInviteLink(ctx context.Context, req *pb.RevokeInviteLinkRequest) (*pb.Response, error) {
+	s.log.Info("Got RevokeInviteLink Request", zap.Any("request", req))
+	err := s.cht_ctrl.RevokeInviteLink(ctx, req.GetUuid())
 	if err != nil {
 		return nil, err
 	}
 	return &pb.Response{}, nil
 }
+
+func (s *ChatsServiceServer) RedeemInviteLink(ctx context.Context, req *pb.RedeemInviteLinkRequest) (*pb.Chat, error) {
+	s.log.Info("Got RedeemInviteLink Request")
+	chat, joined, err := s.cht_ctrl.RedeemInviteLink(ctx, req.GetToken())
+	if err != nil {
+		return nil, err
+	}
+	if joined {
+		requestor := ctx.Value(nocloud.NoCloudAccount).(string)
+		s.publishMember(ctx, pb.ChatEvent_MEMBER_JOINED, chat.GetUuid(), requestor)
+	}
+	return chat.Chat, nil
+}
+
+// publishMember publishes member event with the current member access
+func (s *ChatsServiceServer) publishMember(ctx context.Context, t pb.ChatEvent_Type, chat, account string) {
+	edge, err := s.cht_ctrl.GetMember(ctx, chat, account)
+	if err != nil {
+		s.log.Warn("Error while fetching member", zap.Error(err))
+		return
+	}
+	member := &pb.ChatMember{Uuid: account, AccessLevel: edge.Level, Role: edge.Role}
+	if err := s.PublishMember(ctx, t, chat, member); err != nil {
+		s.log.Warn("Error while publishing member", zap.Error(err))
+	}
+}
+
+func (s *ChatsServiceServer) ListMembers(ctx context.Context, req *pb.ListMembersRequest) (*pb.ListMembersResponse, error) {
+	s.log.Info("Got ListMembers Request", zap.Any("request", req))
+	members, err := s.cht_ctrl.ListMembers(ctx, req.GetChatUuid())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListMembersResponse{Members: members}, nil
+}
+
+func (s *ChatsServiceServer) SetMemberRole(ctx context.Context, req *pb.SetMemberRoleRequest) (*pb.ChatMember, error) {
+	s.log.Info("Got SetMemberRole Request", zap.Any("request", req))
+	member, err := s.cht_ctrl.SetMemberRole(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.PublishMember(ctx, pb.ChatEvent_MEMBER_UPDATED, req.GetChatUuid(), member); err != nil {
+		s.log.Warn("Error while publishing member", zap.Error(err))
+	}
+	return member, nil
+}
+
+func (s *ChatsServiceServer) RemoveMember(ctx context.Context, req *pb.RemoveMemberRequest) (*pb.Response, error) {
+	s.log.Info("Got RemoveMember Request", zap.Any("request", req))
+	err := s.cht_ctrl.RemoveMember(ctx, req.GetChatUuid(), req.GetUserUuid())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.PublishMember(ctx, pb.ChatEvent_MEMBER_LEFT, req.GetChatUuid(), &pb.ChatMember{Uuid: req.GetUserUuid()}); err != nil {
+		s.log.Warn("Error while publishing member", zap.Error(err))
+	}
+	return &pb.Response{}, nil
+}
+
+func (s *ChatsServiceServer) LeaveChat(ctx context.Context, req *pb.LeaveChatRequest) (*pb.Response, error) {
+	s.log.Info("Got LeaveChat Request", zap.Any("request", req))
+	err := s.cht_ctrl.LeaveChat(ctx, req.GetChatUuid())
+	if err != nil {
+		return nil, err
+	}
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
+	if err := s.PublishMember(ctx, pb.ChatEvent_MEMBER_LEFT, req.GetChatUuid(), &pb.ChatMember{Uuid: requestor}); err != nil {
+		s.log.Warn("Error while publishing member", zap.Error(err))
+	}
+	return &pb.Response{}, nil
+}
+
+func (s *ChatsServiceServer) TransferOwnership(ctx context.Context, req *pb.TransferOwnershipRequest) (*pb.Response, error) {
+	s.log.Info("Got TransferOwnership Request", zap.Any("request", req))
+	err := s.cht_ctrl.TransferOwnership(ctx, req.GetChatUuid(), req.GetUserUuid())
+	if err != nil {
+		return nil, err
+	}
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
+	if requestor != req.GetUserUuid() {
+		s.publishMember(ctx, pb.ChatEvent_MEMBER_UPDATED, req.GetChatUuid(), requestor)
+		s.publishMember(ctx, pb.ChatEvent_MEMBER_UPDATED, req.GetChatUuid(), req.GetUserUuid())
+	}
+	return &pb.Response{}, nil
+}
+
 func (s *ChatsServiceServer) ListChatMessages(ctx context.Context, req *pb.ListChatMessagesRequest) (*pb.ListChatMessagesResponse, error) {
 	s.log.Info("Got List Messages Request", zap.Any("request", req))
-	messages, err := s.msg_ctrl.List(ctx, req)
+	res, err := s.msg_ctrl.List(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *ChatsServiceServer) MarkRead(ctx context.Context, req *pb.MarkReadRequest) (*pb.ReadReceipt, error) {
+	s.log.Info("Got MarkRead Request", zap.Any("request", req))
+	receipt, advanced, err := s.cht_ctrl.MarkRead(ctx, req.GetChatUuid(), req.GetMessageUuid())
 	if err != nil {
 		return nil, err
 	}
-	return &pb.ListChatMessagesResponse{Messages: messages}, nil
+	if advanced {
+		if err := s.PublishReadReceipt(ctx, receipt); err != nil {
+			s.log.Warn("Error while publishing read receipt", zap.Error(err))
+		}
+	}
+	return receipt, nil
+}
+
+func (s *ChatsServiceServer) SetTyping(ctx context.Context, req *pb.SetTypingRequest) (*pb.Response, error) {
+	s.log.Debug("Got SetTyping Request", zap.Any("request", req))
+	if err := graph.ChatAccess(ctx, s.db, req.GetChatUuid(), access.READ); err != nil {
+		return nil, err
+	}
+
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
+	now := time.Now()
+	if !s.typing.Allow(req.GetChatUuid(), requestor, req.GetTyping(), now) {
+		return &pb.Response{}, nil
+	}
+
+	err := s.PublishTyping(ctx, &pb.TypingEvent{
+		Chat: req.GetChatUuid(), Account: requestor,
+		Typing: req.GetTyping(), At: now.UnixMilli(),
+	})
+	if err != nil {
+		s.log.Warn("Error while publishing typing event", zap.Error(err))
+	}
+	return &pb.Response{}, nil
 }
 
 func (s *ChatsServiceServer) Stream(req *pb.ChatMessageStreamRequest, stream pb.ChatService_StreamServer) error {
 	s.log.Info("Got ChatMessageStream Request", zap.Any("request", req))
 	uuid := req.GetUuid()
 
-	if !graph.HasAccess(stream.Context(), s.db, schema.ACC2CHTS, uuid, access.READ) {
-		s.log.Warn("Access check failed", zap.Any("context", stream.Context()))
-		return status.Error(codes.PermissionDenied, "Not enough access to subscribe to chat")
+	if err := graph.ChatAccess(stream.Context(), s.db, uuid, access.READ); err != nil {
+		s.log.Warn("Access check failed", zap.Any("context", stream.Context()), zap.Error(err))
+		return err
+	}
+
+	// Queue is bound before replay, so messages sent meanwhile are
+	// delivered live and ones already replayed are skipped
+	sub, err := s.broker.Subscribe(stream.Context(), uuid)
+	if err != nil {
+		return err
+	}
+
+	replayed, err := s.replay(stream.Context(), req, stream.Send)
+	if err != nil {
+		return err
+	}
+
+	// Deliveries are acked once handled, so at most prefetch of them are in flight
+	for msg := range sub.Deliveries() {
+		event, err := DecodeChatEvent(msg.Type, msg.Body)
+		if err != nil {
+			s.log.Warn("Failed to decode chat event", zap.String("type", msg.Type), zap.Error(err))
+			s.ack(msg)
+			continue
+		}
+		if event.GetType() == pb.ChatEvent_MESSAGE_CREATED && replayed[event.GetMessage().GetSeq()] {
+			s.ack(msg)
+			continue
+		}
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+		s.ack(msg)
+
+		if event.GetType() == pb.ChatEvent_CHAT_DELETED {
+			return status.Error(codes.NotFound, "Chat has been deleted")
+		}
+	}
+
+	return subscriptionEnded(stream.Context())
+}
+
+// replay sends persisted messages newer than the requested one, returns seqs of the sent messages.
+// Seqs are reserved before messages are stored, so live delivery is deduplicated
+// by the exact seqs rather than the last replayed one
+func (s *ChatsServiceServer) replay(ctx context.Context, req *pb.ChatMessageStreamRequest, send func(*pb.ChatEvent) error) (map[int64]bool, error) {
+	replayed := make(map[int64]bool)
+
+	since := req.GetSinceSeq()
+	if req.GetSinceMessage() != "" {
+		seq, err := s.msg_ctrl.MessageSeq(ctx, req.GetUuid(), req.GetSinceMessage())
+		if err != nil {
+			return nil, err
+		}
+		since = seq
+	} else if since <= 0 {
+		return replayed, nil
 	}
 
-	msgs, err := broker.GetConsumer(stream.Context(), uuid)
+	for {
+		messages, err := s.msg_ctrl.Replay(ctx, req.GetUuid(), since, graph.MaxMessagesPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, msg := range messages {
+			err := send(&pb.ChatEvent{
+				Type:     pb.ChatEvent_MESSAGE_CREATED,
+				Payload:  &pb.ChatEvent_Message{Message: msg},
+				ChatUuid: req.GetUuid(),
+				At:       msg.GetSentAt(),
+			})
+			if err != nil {
+				return nil, err
+			}
+			since = msg.GetSeq()
+			replayed[since] = true
+		}
+		if len(messages) < graph.MaxMessagesPageSize {
+			return replayed, nil
+		}
+	}
+}
+
+// StreamAll multiplexes events of every chat requestor can read into one stream.
+// Queue is bound to the account key as well, so it's rebound once requestor joins or leaves chats
+func (s *ChatsServiceServer) StreamAll(req *pb.StreamAllRequest, stream pb.ChatService_StreamAllServer) error {
+	ctx := stream.Context()
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
+	log := s.log.Named("StreamAll").With(zap.String("account", requestor))
+	log.Info("Got StreamAll Request")
+
+	window := newDeliveredWindow(nil)
+	var since *graph.Cursor
+	if req.GetCursor() != "" {
+		cursor, err := decodeStreamCursor(req.GetCursor())
+		if err != nil {
+			return status.Error(codes.InvalidArgument, "Invalid cursor")
+		}
+		window = newDeliveredWindow(cursor)
+		since = &graph.Cursor{Value: window.from()}
+	} else if req.GetSince() > 0 {
+		since = &graph.Cursor{Value: req.GetSince()}
+	}
+
+	account := broker.AccountKey(requestor)
+	sub, err := s.broker.Subscribe(ctx, account)
 	if err != nil {
 		return err
 	}
 
-	for msg := range msgs {
-		s.log.Info("Unmarshaling incoming message")
-		chatMessage := &pb.ChatMessage{}
-		json.Unmarshal(msg.Body, chatMessage)
-		stream.Send(chatMessage)
+	chats, err := s.cht_ctrl.MemberOf(ctx, access.READ)
+	if err != nil {
+		log.Error("Failed to list chats", zap.Error(err))
+		return status.Error(codes.Internal, "Failed to list chats")
+	}
+	bound := make(map[string]bool, len(chats))
+	for _, chat := range chats {
+		if err := sub.Bind(chat); err != nil {
+			return status.Error(codes.Internal, "Failed to subscribe to chats")
+		}
+		bound[chat] = true
 	}
 
-	return nil
+	replayed, err := s.replayAll(stream, chats, since, window)
+	if err != nil {
+		return err
+	}
+
+	for msg := range sub.Deliveries() {
+		event, err := DecodeChatEvent(msg.Type, msg.Body)
+		if err != nil {
+			log.Warn("Failed to decode chat event", zap.String("type", msg.Type), zap.Error(err))
+			s.ack(msg)
+			continue
+		}
+		if event.GetType() == pb.ChatEvent_MESSAGE_CREATED {
+			if replayed[event.GetMessage().GetUuid()] || window.delivered(event.GetMessage()) {
+				s.ack(msg)
+				continue
+			}
+			event.Cursor = window.add(event.GetMessage())
+		}
+		chat := event.GetChatUuid()
+
+		// Account events duplicate chat events for the chats queue is bound to
+		if msg.RoutingKey == account {
+			wasBound := bound[chat]
+			switch {
+			// Chat events reach accounts once chat is restored
+			case (event.GetType() == pb.ChatEvent_MEMBER_JOINED || event.GetType() == pb.ChatEvent_CHAT_UPDATED) && !wasBound:
+				if err := sub.Bind(chat); err != nil {
+					s.ack(msg)
+					continue
+				}
+				bound[chat] = true
+			case event.GetType() == pb.ChatEvent_MEMBER_LEFT && wasBound:
+				if err := sub.Unbind(chat); err == nil {
+					delete(bound, chat)
+				}
+			}
+			if wasBound {
+				s.ack(msg)
+				continue
+			}
+		}
+
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+		s.ack(msg)
+
+		if event.GetType() == pb.ChatEvent_CHAT_DELETED && bound[chat] {
+			if err := sub.Unbind(chat); err == nil {
+				delete(bound, chat)
+			}
+		}
+	}
+
+	return subscriptionEnded(ctx)
+}
+
+// replayAll sends messages of the given chats sent after the cursor, but ones delivered already,
+// returns uuids of the sent messages
+func (s *ChatsServiceServer) replayAll(stream pb.ChatService_StreamAllServer, chats []string, cursor *graph.Cursor, window *deliveredWindow) (map[string]bool, error) {
+	replayed := make(map[string]bool)
+	if cursor == nil || len(chats) == 0 {
+		return replayed, nil
+	}
+
+	for {
+		messages, err := s.msg_ctrl.ReplayAll(stream.Context(), chats, cursor, graph.MaxMessagesPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, msg := range messages {
+			cursor = &graph.Cursor{Value: msg.GetSentAt(), Key: msg.GetUuid()}
+			if window.delivered(msg) {
+				continue
+			}
+			err := stream.Send(&pb.ChatEvent{
+				Type:     pb.ChatEvent_MESSAGE_CREATED,
+				Payload:  &pb.ChatEvent_Message{Message: msg},
+				ChatUuid: msg.GetTo(),
+				At:       msg.GetSentAt(),
+				Cursor:   window.add(msg),
+			})
+			if err != nil {
+				return nil, err
+			}
+			replayed[msg.GetUuid()] = true
+		}
+		if len(messages) < graph.MaxMessagesPageSize {
+			return replayed, nil
+		}
+	}
 }
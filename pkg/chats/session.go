@@ -0,0 +1,235 @@
+package chats
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	pb "github.com/slntopp/nocloud-cc/cc"
+	"github.com/slntopp/nocloud-cc/pkg/broker"
+	"github.com/slntopp/nocloud-cc/pkg/graph"
+	"github.com/slntopp/nocloud/pkg/nocloud"
+	"github.com/slntopp/nocloud/pkg/nocloud/access"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// session serializes writes to the Session stream, events and acks are sent from different goroutines
+type session struct {
+	mu     sync.Mutex
+	stream pb.ChatService_SessionServer
+
+	sub broker.Subscription
+	// Subscribed chats with seqs of messages replayed on subscribe. Deliveries are
+	// handled under chatsMu, so replay isn't interleaved with live events
+	chatsMu sync.Mutex
+	chats   map[string]map[int64]bool
+}
+
+func (s *session) Send(event *pb.SessionEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stream.Send(event)
+}
+
+func (s *session) SendChatEvent(event *pb.ChatEvent) error {
+	return s.Send(&pb.SessionEvent{Event: &pb.SessionEvent_ChatEvent{ChatEvent: event}})
+}
+
+// unsubscribe must be called with chatsMu held
+func (s *session) unsubscribe(chat string) error {
+	if err := s.sub.Unbind(chat); err != nil {
+		return err
+	}
+	delete(s.chats, chat)
+	return nil
+}
+
+// Session handles client frames and streams events of subscribed chats over the same connection
+func (s *ChatsServiceServer) Session(stream pb.ChatService_SessionServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	defer cancel()
+
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
+	log := s.log.Named("Session").With(zap.String("account", requestor))
+	log.Info("Session started")
+
+	sub, err := s.broker.Subscribe(ctx)
+	if err != nil {
+		return err
+	}
+	sess := &session{stream: stream, sub: sub, chats: make(map[string]map[int64]bool)}
+
+	pumped := make(chan error, 1)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		pumped <- s.pump(ctx, log, sess, requestor)
+	}()
+
+	// Recv is only interrupted by returning from the handler, reader ends right after that
+	frames := make(chan *pb.SessionFrame)
+	received := make(chan error, 1)
+	go func() {
+		for {
+			frame, err := stream.Recv()
+			if err != nil {
+				received <- err
+				return
+			}
+			select {
+			case frames <- frame:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case err := <-pumped:
+			return err
+		case err := <-received:
+			if errors.Is(err, io.EOF) {
+				log.Info("Session closed")
+				return nil
+			}
+			return err
+		case frame := <-frames:
+			ack := s.handleFrame(ctx, sess, frame)
+			ack.CorrelationId = frame.GetCorrelationId()
+			if err := sess.Send(&pb.SessionEvent{Event: &pb.SessionEvent_Ack{Ack: ack}}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// pump delivers events of subscribed chats until session ends or subscription is lost
+func (s *ChatsServiceServer) pump(ctx context.Context, log *zap.Logger, sess *session, requestor string) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-sess.sub.Deliveries():
+			if !ok {
+				return subscriptionEnded(ctx)
+			}
+			if err := s.deliver(log, sess, requestor, msg); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// deliver sends event of a subscribed chat, the chat is unsubscribed once it's
+// deleted or requestor has left it, same as Stream and StreamAll do
+func (s *ChatsServiceServer) deliver(log *zap.Logger, sess *session, requestor string, msg broker.Delivery) error {
+	sess.chatsMu.Lock()
+	defer sess.chatsMu.Unlock()
+
+	chat := msg.RoutingKey
+	replayed, ok := sess.chats[chat]
+	if !ok {
+		// Delivered before the chat was unsubscribed
+		s.ack(msg)
+		return nil
+	}
+	event, err := DecodeChatEvent(msg.Type, msg.Body)
+	if err != nil {
+		log.Warn("Failed to decode chat event", zap.String("type", msg.Type), zap.Error(err))
+		s.ack(msg)
+		return nil
+	}
+	if event.GetType() == pb.ChatEvent_MESSAGE_CREATED && replayed[event.GetMessage().GetSeq()] {
+		s.ack(msg)
+		return nil
+	}
+	if err := sess.SendChatEvent(event); err != nil {
+		return err
+	}
+	s.ack(msg)
+
+	left := event.GetType() == pb.ChatEvent_MEMBER_LEFT && event.GetMember().GetUuid() == requestor
+	if left || event.GetType() == pb.ChatEvent_CHAT_DELETED {
+		if err := sess.unsubscribe(chat); err != nil {
+			log.Warn("Failed to unsubscribe from chat", zap.String("chat", chat), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// handleFrame processes client frame the same way corresponding unary RPC does
+func (s *ChatsServiceServer) handleFrame(ctx context.Context, sess *session, frame *pb.SessionFrame) *pb.SessionAck {
+	ack := &pb.SessionAck{}
+	var err error
+
+	switch f := frame.GetFrame().(type) {
+	case *pb.SessionFrame_Send:
+		var msg *pb.ChatMessage
+		msg, err = s.SendChatMessage(ctx, f.Send)
+		ack.Result = &pb.SessionAck_Message{Message: msg}
+	case *pb.SessionFrame_Edit:
+		var msg *pb.ChatMessage
+		msg, err = s.PatchChatMessage(ctx, f.Edit)
+		ack.Result = &pb.SessionAck_Message{Message: msg}
+	case *pb.SessionFrame_Typing:
+		_, err = s.SetTyping(ctx, f.Typing)
+	case *pb.SessionFrame_MarkRead:
+		var receipt *pb.ReadReceipt
+		receipt, err = s.MarkRead(ctx, f.MarkRead)
+		ack.Result = &pb.SessionAck_Receipt{Receipt: receipt}
+	case *pb.SessionFrame_Subscribe:
+		err = s.subscribe(ctx, sess, f.Subscribe)
+	case *pb.SessionFrame_Unsubscribe:
+		sess.chatsMu.Lock()
+		if sess.unsubscribe(f.Unsubscribe.GetChatUuid()) != nil {
+			err = status.Error(codes.Internal, "Failed to unsubscribe from chat")
+		}
+		sess.chatsMu.Unlock()
+	default:
+		err = status.Error(codes.InvalidArgument, "Unknown frame")
+	}
+
+	if err != nil {
+		st := status.Convert(err)
+		return &pb.SessionAck{Code: int32(st.Code()), Error: st.Message()}
+	}
+	return ack
+}
+
+// subscribe binds the chat and replays messages requested. Live deliveries wait
+// for the replay, so messages sent meanwhile are delivered once and in order
+func (s *ChatsServiceServer) subscribe(ctx context.Context, sess *session, req *pb.SessionSubscription) error {
+	chat := req.GetChatUuid()
+	if err := graph.ChatAccess(ctx, s.db, chat, access.READ); err != nil {
+		return err
+	}
+
+	sess.chatsMu.Lock()
+	defer sess.chatsMu.Unlock()
+
+	if _, ok := sess.chats[chat]; !ok {
+		if sess.sub.Bind(chat) != nil {
+			return status.Error(codes.Internal, "Failed to subscribe to chat")
+		}
+		sess.chats[chat] = map[int64]bool{}
+	}
+
+	replayed, err := s.replay(ctx, &pb.ChatMessageStreamRequest{
+		Uuid:         chat,
+		SinceSeq:     req.GetSinceSeq(),
+		SinceMessage: req.GetSinceMessage(),
+	}, sess.SendChatEvent)
+	if err != nil {
+		return err
+	}
+	for seq := range replayed {
+		sess.chats[chat][seq] = true
+	}
+	return nil
+}
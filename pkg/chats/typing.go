@@ -0,0 +1,64 @@
+package chats
+
+import (
+	"sync"
+	"time"
+)
+
+var (
+	// Typing events are dropped if not delivered within TypingTTL
+	TypingTTL = 5 * time.Second
+	// Same typing state of the account is published at most once per TypingThrottle
+	TypingThrottle = 3 * time.Second
+)
+
+type typingState struct {
+	typing bool
+	at     time.Time
+}
+
+type typingThrottle struct {
+	mu     sync.Mutex
+	states map[string]typingState
+	swept  time.Time
+}
+
+func newTypingThrottle() *typingThrottle {
+	return &typingThrottle{states: make(map[string]typingState)}
+}
+
+// Allow reports whether typing event should be published, repeating the last
+// published state within TypingThrottle is not
+func (t *typingThrottle) Allow(chat, account string, typing bool, now time.Time) bool {
+	key := chat + "/" + account
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.sweep(now)
+
+	last, ok := t.states[key]
+	if ok && last.typing == typing && now.Sub(last.at) < TypingThrottle {
+		return false
+	}
+	if !typing {
+		delete(t.states, key)
+		return ok && last.typing
+	}
+	t.states[key] = typingState{typing: typing, at: now}
+	return true
+}
+
+// sweep evicts states of accounts which stopped sending typing events without
+// typing=false, at most once per TypingThrottle. The typing event such state
+// would stop has expired within TypingTTL anyway
+func (t *typingThrottle) sweep(now time.Time) {
+	if now.Sub(t.swept) < TypingThrottle {
+		return
+	}
+	t.swept = now
+	for key, state := range t.states {
+		if now.Sub(state.at) >= TypingTTL {
+			delete(t.states, key)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/websocket"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	pb "github.com/slntopp/nocloud-cc/cc"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// NewHandler serves REST routes of the ChatService proxied to the gRPC endpoint,
+// WebSocket upgrade and event-stream requests to the stream routes are bridged to the gRPC streams.
+// WebSocket connections are accepted from the given origins ("*" is any), same origin only if none given
+func NewHandler(ctx context.Context, logger *zap.Logger, endpoint string, origins []string) (http.Handler, error) {
+	log := logger.Named("Gateway")
+
+	conn, err := grpc.DialContext(ctx, endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		<-ctx.Done()
+		if err := conn.Close(); err != nil {
+			log.Warn("Failed to close gRPC connection", zap.Error(err))
+		}
+	}()
+
+	mux := runtime.NewServeMux()
+	if err := pb.RegisterChatServiceHandler(ctx, mux, conn); err != nil {
+		return nil, err
+	}
+
+	streams := &bridge{
+		log:      log.Named("Streams"),
+		client:   pb.NewChatServiceClient(conn),
+		upgrader: websocket.Upgrader{CheckOrigin: checkOrigin(origins)},
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case isWebSocket(r):
+			streams.ServeWebSocket(w, r)
+		case isEventStream(r):
+			streams.ServeEvents(w, r)
+		default:
+			mux.ServeHTTP(w, r)
+		}
+	}), nil
+}
+
+func isWebSocket(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket") &&
+		strings.Contains(strings.ToLower(r.Header.Get("Connection")), "upgrade")
+}
+
+// checkOrigin returns nil for no origins, so upgrader falls back to the same origin check
+func checkOrigin(origins []string) func(r *http.Request) bool {
+	if len(origins) == 0 {
+		return nil
+	}
+	allowed := make(map[string]bool, len(origins))
+	for _, origin := range origins {
+		allowed[strings.ToLower(strings.TrimSuffix(origin, "/"))] = true
+	}
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		// Non-browser clients don't send Origin
+		return origin == "" || allowed["*"] || allowed[strings.ToLower(origin)]
+	}
+}
+
+func isEventStream(r *http.Request) bool {
+	return r.Method == http.MethodGet && strings.Contains(r.Header.Get("Accept"), "text/event-stream")
+}
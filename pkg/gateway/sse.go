@@ -0,0 +1,132 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	pb "github.com/slntopp/nocloud-cc/cc"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Interval between keep-alive comments, keeps proxies from closing idle streams
+var KeepAliveInterval = 15 * time.Second
+
+type recvResult struct {
+	event *pb.ChatEvent
+	err   error
+}
+
+// ServeEvents bridges the gRPC stream to Server-Sent Events named after event types.
+// Stored messages carry ids, so reconnecting client resumes from Last-Event-ID
+func (b *bridge) ServeEvents(w http.ResponseWriter, r *http.Request) {
+	route, ok := parseStreamRoute(r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	token := requestToken(r)
+	if token == "" {
+		http.Error(w, "Unauthenticated", http.StatusUnauthorized)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	query := r.URL.Query()
+	last := r.Header.Get("Last-Event-ID")
+	if last == "" {
+		last = route.resumeFrom(query)
+	}
+	stream, err := b.open(ctx, route, token, last, query.Get("since_message"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	events := make(chan recvResult)
+	go func() {
+		defer close(events)
+		for {
+			event, err := stream.Recv()
+			select {
+			case events <- recvResult{event, err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	header := w.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	header.Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ticker := time.NewTicker(KeepAliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := io.WriteString(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		case res, ok := <-events:
+			if !ok {
+				return
+			}
+			if res.err != nil {
+				if !errors.Is(res.err, io.EOF) {
+					writeStreamError(w, res.err)
+				}
+				flusher.Flush()
+				return
+			}
+			if err := b.writeEvent(w, route, res.event); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
+
+func (b *bridge) writeEvent(w io.Writer, route *streamRoute, event *pb.ChatEvent) error {
+	data, err := protojson.Marshal(event)
+	if err != nil {
+		b.log.Warn("Failed to marshal event", zap.Error(err))
+		return nil
+	}
+	if id := route.eventID(event); id != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", strings.ToLower(event.GetType().String()), data)
+	return err
+}
+
+// writeStreamError sends "error" event with gRPC status of the ended stream
+func writeStreamError(w io.Writer, err error) {
+	data, _ := protojson.Marshal(status.Convert(err).Proto())
+	_, _ = fmt.Fprintf(w, "event: error\ndata: %s\n\n", data)
+}
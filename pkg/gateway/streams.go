@@ -0,0 +1,100 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/websocket"
+	pb "github.com/slntopp/nocloud-cc/cc"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+)
+
+// Browsers can't set headers on WebSocket and EventSource requests,
+// so the token may be taken from this query parameter instead
+const TokenParam = "token"
+
+type bridge struct {
+	log      *zap.Logger
+	client   pb.ChatServiceClient
+	upgrader websocket.Upgrader
+}
+
+type eventStream interface {
+	Recv() (*pb.ChatEvent, error)
+}
+
+// streamRoute is the stream served on the request path, either of one chat or of the whole account
+type streamRoute struct {
+	chat string
+}
+
+func (r *streamRoute) account() bool {
+	return r.chat == ""
+}
+
+// resumeFrom returns the resumption point requested with query parameters named after the request fields
+func (r *streamRoute) resumeFrom(query url.Values) string {
+	if !r.account() {
+		return query.Get("since_seq")
+	}
+	if cursor := query.Get("cursor"); cursor != "" {
+		return cursor
+	}
+	return query.Get("since")
+}
+
+// parseStreamRoute matches paths of the Stream and StreamAll RPCs
+func parseStreamRoute(path string) (*streamRoute, bool) {
+	path = strings.Trim(path, "/")
+	if path == "chats/stream" {
+		return &streamRoute{}, true
+	}
+	parts := strings.Split(path, "/")
+	if len(parts) == 3 && parts[0] == "messages" && parts[1] != "" && parts[2] == "stream" {
+		return &streamRoute{chat: parts[1]}, true
+	}
+	return nil, false
+}
+
+// open calls the gRPC stream of the route on behalf of the token owner.
+// last is seq of the last seen message for chat streams and its cursor for account streams,
+// numeric one is taken as sent_at for account streams resumed from ids issued before cursors
+func (b *bridge) open(ctx context.Context, route *streamRoute, token string, last string, sinceMessage string) (eventStream, error) {
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "bearer "+token)
+	since, err := strconv.ParseInt(last, 10, 64)
+	if route.account() {
+		if err != nil {
+			return b.client.StreamAll(ctx, &pb.StreamAllRequest{Cursor: last})
+		}
+		return b.client.StreamAll(ctx, &pb.StreamAllRequest{Since: since})
+	}
+	return b.client.Stream(ctx, &pb.ChatMessageStreamRequest{
+		Uuid: route.chat, SinceSeq: since, SinceMessage: sinceMessage,
+	})
+}
+
+// eventID is the resumption point after the event, only stored messages have one
+func (r *streamRoute) eventID(event *pb.ChatEvent) string {
+	if event.GetType() != pb.ChatEvent_MESSAGE_CREATED {
+		return ""
+	}
+	if r.account() {
+		return event.GetCursor()
+	}
+	return strconv.FormatInt(event.GetMessage().GetSeq(), 10)
+}
+
+// requestToken returns JWT from the query or Authorization header
+func requestToken(r *http.Request) string {
+	if token := r.URL.Query().Get(TokenParam); token != "" {
+		return token
+	}
+	if auth := r.Header.Get("Authorization"); len(auth) > 7 && strings.EqualFold(auth[:7], "bearer ") {
+		return auth[7:]
+	}
+	return ""
+}
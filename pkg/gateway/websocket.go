@@ -0,0 +1,104 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Token may also be passed as "bearer, <token>" subprotocols
+const BearerSubprotocol = "bearer"
+
+const writeTimeout = 10 * time.Second
+
+// ServeWebSocket bridges the gRPC stream to WebSocket, events are sent as JSON text frames
+func (b *bridge) ServeWebSocket(w http.ResponseWriter, r *http.Request) {
+	route, ok := parseStreamRoute(r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	token, subprotocol := wsToken(r)
+	if token == "" {
+		http.Error(w, "Unauthenticated", http.StatusUnauthorized)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	query := r.URL.Query()
+	stream, err := b.open(ctx, route, token, route.resumeFrom(query), query.Get("since_message"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	var header http.Header
+	if subprotocol != "" {
+		header = http.Header{"Sec-WebSocket-Protocol": []string{subprotocol}}
+	}
+	conn, err := b.upgrader.Upgrade(w, r, header)
+	if err != nil {
+		b.log.Debug("Failed to upgrade connection", zap.Error(err))
+		return
+	}
+	defer conn.Close()
+
+	// Reading is required to process control frames, client closing the socket ends the stream
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			closeWith(conn, err)
+			return
+		}
+		data, err := protojson.Marshal(event)
+		if err != nil {
+			b.log.Warn("Failed to marshal event", zap.Error(err))
+			continue
+		}
+		_ = conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+		if err := conn.WriteMessage(websocket.TextMessage, data); err != nil {
+			return
+		}
+	}
+}
+
+// closeWith sends close frame matching the way gRPC stream has ended
+func closeWith(conn *websocket.Conn, err error) {
+	code, text := websocket.CloseNormalClosure, ""
+	if !errors.Is(err, io.EOF) {
+		st := status.Convert(err)
+		code, text = websocket.CloseInternalServerErr, st.Code().String()+": "+st.Message()
+	}
+	msg := websocket.FormatCloseMessage(code, text)
+	_ = conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(writeTimeout))
+}
+
+// wsToken returns JWT of the request and subprotocol to accept if it came in subprotocols
+func wsToken(r *http.Request) (string, string) {
+	protocols := websocket.Subprotocols(r)
+	for i, p := range protocols {
+		if strings.EqualFold(p, BearerSubprotocol) && i+1 < len(protocols) {
+			return protocols[i+1], p
+		}
+	}
+	return requestToken(r), ""
+}
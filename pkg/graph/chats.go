@@ -2,10 +2,12 @@ package graph
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/arangodb/go-driver"
+	pb "github.com/slntopp/nocloud-cc/cc"
 	"github.com/slntopp/nocloud-cc/pkg/schema"
-	pb "github.com/slntopp/nocloud-proto/cc"
 	nograph "github.com/slntopp/nocloud/pkg/graph"
 	"github.com/slntopp/nocloud/pkg/nocloud"
 	"github.com/slntopp/nocloud/pkg/nocloud/access"
@@ -14,6 +16,8 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 type Chat struct {
@@ -26,6 +30,8 @@ type ChatsController struct {
 	col      driver.Collection
 	graph    driver.Graph
 	acc2chts driver.Collection
+	invites  driver.Collection
+	links    driver.Collection
 }
 
 type ChatMessage struct {
@@ -34,11 +40,15 @@ type ChatMessage struct {
 }
 
 type ChatsMessagesController struct {
-	log     *zap.Logger
-	db      driver.Database
-	col     driver.Collection
-	acc2msg driver.Collection
-	graph   driver.Graph
+	log       *zap.Logger
+	db        driver.Database
+	col       driver.Collection
+	acc2msg   driver.Collection
+	graph     driver.Graph
+	revisions driver.Collection
+	reactions driver.Collection
+	outbox    driver.Collection
+	cht_ctrl  ChatsController
 }
 
 func NewChatsController(logger *zap.Logger, db driver.Database) ChatsController {
@@ -53,10 +63,37 @@ func NewChatsController(logger *zap.Logger, db driver.Database) ChatsController
 
 	acc2chts := nograph.GraphGetEdgeEnsure(log, ctx, graph, schema.ACC2CHTS, noschema.ACCOUNTS_COL, schema.CHATS_COL)
 
-	return ChatsController{log: log, col: col, graph: graph, acc2chts: acc2chts, db: db}
+	dedupeMembers(log, ctx, db)
+	/* #nosec */
+	acc2chts.EnsurePersistentIndex(ctx, []string{"_from", "_to"}, &driver.EnsurePersistentIndexOptions{
+		Unique: true, Sparse: false, InBackground: true, Name: "member-account-chat",
+	})
+
+	invites := nograph.GetEnsureCollection(log, ctx, db, schema.CHATS_INVITES_COL)
+	/* #nosec */
+	invites.EnsurePersistentIndex(ctx, []string{"chat", "to"}, &driver.EnsurePersistentIndexOptions{
+		Unique: true, Sparse: false, InBackground: true, Name: "invite-chat-account",
+	})
+	/* #nosec */
+	invites.EnsurePersistentIndex(ctx, []string{"to"}, &driver.EnsurePersistentIndexOptions{
+		Unique: false, Sparse: false, InBackground: true, Name: "invite-account",
+	})
+
+	/* #nosec */
+	col.EnsurePersistentIndex(ctx, []string{"deleted_at"}, &driver.EnsurePersistentIndexOptions{
+		Unique: false, Sparse: true, InBackground: true, Name: "chat-deleted",
+	})
+
+	links := nograph.GetEnsureCollection(log, ctx, db, schema.CHATS_LINKS_COL)
+	/* #nosec */
+	links.EnsurePersistentIndex(ctx, []string{"chat"}, &driver.EnsurePersistentIndexOptions{
+		Unique: false, Sparse: false, InBackground: true, Name: "link-chat",
+	})
+
+	return ChatsController{log: log, col: col, graph: graph, acc2chts: acc2chts, invites: invites, links: links, db: db}
 }
 
-func NewChatsMessagesController(logger *zap.Logger, db driver.Database) ChatsMessagesController {
+func NewChatsMessagesController(logger *zap.Logger, db driver.Database, chats ChatsController) ChatsMessagesController {
 	ctx := context.TODO()
 	log := logger.Named("ChatsMessagesController")
 	log.Info("Creating ChatsMessagesController")
@@ -66,7 +103,50 @@ func NewChatsMessagesController(logger *zap.Logger, db driver.Database) ChatsMes
 
 	acc2msg := nograph.GraphGetEdgeEnsure(log, ctx, graph, schema.ACC2MSG, noschema.ACCOUNTS_COL, schema.CHATS_MESSAGES_COL)
 
-	return ChatsMessagesController{log: log, col: col, graph: graph, acc2msg: acc2msg, db: db}
+	migrateMessagesOrdering(log, ctx, db)
+	/* #nosec */
+	col.EnsurePersistentIndex(ctx, []string{"to", "seq"}, &driver.EnsurePersistentIndexOptions{
+		Unique: false, Sparse: false, InBackground: true, Name: "message-chat-seq",
+	})
+	/* #nosec */
+	col.EnsurePersistentIndex(ctx, []string{"to", "sent_at"}, &driver.EnsurePersistentIndexOptions{
+		Unique: false, Sparse: false, InBackground: true, Name: "message-chat-sent",
+	})
+	/* #nosec */
+	col.EnsurePersistentIndex(ctx, []string{"deleted_at"}, &driver.EnsurePersistentIndexOptions{
+		Unique: false, Sparse: true, InBackground: true, Name: "message-deleted",
+	})
+	/* #nosec */
+	col.EnsurePersistentIndex(ctx, []string{"reply_to", "seq"}, &driver.EnsurePersistentIndexOptions{
+		Unique: false, Sparse: true, InBackground: true, Name: "message-thread",
+	})
+
+	revisions := nograph.GetEnsureCollection(log, ctx, db, schema.CHATS_REVISIONS_COL)
+	/* #nosec */
+	revisions.EnsurePersistentIndex(ctx, []string{"message_uuid", "edited_at"}, &driver.EnsurePersistentIndexOptions{
+		Unique: false, Sparse: false, InBackground: true, Name: "revision-message",
+	})
+	/* #nosec */
+	revisions.EnsurePersistentIndex(ctx, []string{"chat"}, &driver.EnsurePersistentIndexOptions{
+		Unique: false, Sparse: false, InBackground: true, Name: "revision-chat",
+	})
+
+	reactions := nograph.GetEnsureCollection(log, ctx, db, schema.CHATS_REACTIONS_COL)
+	/* #nosec */
+	reactions.EnsurePersistentIndex(ctx, []string{"message", "account", "emoji"}, &driver.EnsurePersistentIndexOptions{
+		Unique: true, Sparse: false, InBackground: true, Name: "reaction-message-account-emoji",
+	})
+	/* #nosec */
+	reactions.EnsurePersistentIndex(ctx, []string{"chat"}, &driver.EnsurePersistentIndexOptions{
+		Unique: false, Sparse: false, InBackground: true, Name: "reaction-chat",
+	})
+
+	outbox := nograph.GetEnsureCollection(log, ctx, db, schema.CHATS_OUTBOX_COL)
+
+	return ChatsMessagesController{
+		log: log, col: col, graph: graph, acc2msg: acc2msg, db: db,
+		revisions: revisions, reactions: reactions, outbox: outbox, cht_ctrl: chats,
+	}
 }
 
 // Get Chat by id from the database
@@ -80,39 +160,87 @@ func (ctrl *ChatsController) Get(ctx context.Context, id string) (*Chat, error)
 		return nil, err
 	}
 	chat.Uuid = meta.ID.Key()
+	chat.Rev = meta.Rev
 
 	if !HasAccess(ctx, ctrl.db, schema.ACC2CHTS, id, access.READ) {
 		return nil, status.Error(codes.PermissionDenied, "Permission Denied")
 	}
+	if chat.GetDeletedAt() != 0 {
+		return nil, status.Error(codes.NotFound, "Chat not found")
+	}
 
 	return &Chat{chat, meta}, nil
 
 }
 
+// Delete marks Chat as deleted, it's purged with everything related after retention period
 func (ctrl *ChatsController) Delete(ctx context.Context, id string) error {
 	logger := ctrl.log.Named("DeleteChat")
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
 	logger.Info("Deleting chat", zap.String("id", id))
 
 	if !HasAccess(ctx, ctrl.db, schema.ACC2CHTS, id, access.MGMT) {
 		return status.Error(codes.PermissionDenied, "Permission Denied")
 	}
 
-	_, err := ctrl.col.RemoveDocument(ctx, id)
+	chat := &pb.Chat{}
+	_, err := ctrl.col.ReadDocument(ctx, id, chat)
+	if err != nil {
+		return err
+	}
+	if chat.GetDeletedAt() != 0 {
+		return status.Error(codes.NotFound, "Chat not found")
+	}
 
+	_, err = ctrl.col.UpdateDocument(ctx, id, map[string]interface{}{
+		"deleted_at": time.Now().UnixMilli(),
+		"deleted_by": requestor,
+	})
 	return err
 }
 
+// Restore deleted Chat, only owners are allowed to
+func (ctrl *ChatsController) Restore(ctx context.Context, id string) (*Chat, error) {
+	logger := ctrl.log.Named("RestoreChat")
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
+	logger.Info("Restoring chat", zap.String("id", id))
+
+	member, err := ctrl.GetMember(ctx, id, requestor)
+	if err != nil || member.Role != roles.OWNER {
+		return nil, status.Error(codes.PermissionDenied, "Permission Denied")
+	}
+
+	chat := &pb.Chat{}
+	meta, err := ctrl.col.UpdateDocument(driver.WithKeepNull(driver.WithReturnNew(ctx, chat), false), id, map[string]interface{}{
+		"deleted_at": nil,
+		"deleted_by": nil,
+	})
+	if err != nil {
+		return nil, err
+	}
+	chat.Uuid = meta.ID.Key()
+	chat.Rev = meta.Rev
+
+	return &Chat{chat, meta}, nil
+}
+
 func (ctrl *ChatsController) Create(ctx context.Context, chat *pb.Chat) (*Chat, error) {
 	logger := ctrl.log.Named("CreatingChat")
 	logger.Info("Creating chat", zap.String("id", chat.GetUuid()), zap.Any("chat", chat))
 	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
 
+	chat.LastSeq = 0
+	chat.LastActivity = time.Now().UnixMilli()
+	chat.DeletedAt, chat.DeletedBy = 0, ""
+	chat.Rev = ""
+
 	meta, err := ctrl.col.CreateDocument(ctx, chat)
 	if err != nil {
 		return nil, err
 	}
 
 	chat.Uuid = meta.ID.Key()
+	chat.Rev = meta.Rev
 
 	_, err = ctrl.acc2chts.CreateDocument(ctx, nograph.Access{
 		From:  driver.NewDocumentID(noschema.ACCOUNTS_COL, requestor),
@@ -127,68 +255,314 @@ func (ctrl *ChatsController) Create(ctx context.Context, chat *pb.Chat) (*Chat,
 	return &Chat{chat, meta}, nil
 }
 
-func (ctrl *ChatsController) Update(ctx context.Context, chat *pb.Chat) error {
+// Update Chat fields listed in mask, if Chat revision is given it must match the stored one
+func (ctrl *ChatsController) Update(ctx context.Context, chat *pb.Chat, mask *fieldmaskpb.FieldMask) (*Chat, error) {
 	logger := ctrl.log.Named("UpdateChat")
-	logger.Info("Updating chat", zap.String("id", chat.GetUuid()), zap.Any("chat", chat))
+	logger.Info("Updating chat", zap.String("id", chat.GetUuid()), zap.Any("chat", chat), zap.Strings("mask", mask.GetPaths()))
 
 	if !HasAccess(ctx, ctrl.db, schema.ACC2CHTS, chat.GetUuid(), access.MGMT) {
-		return status.Error(codes.PermissionDenied, "Permission Denied")
+		return nil, status.Error(codes.PermissionDenied, "Permission Denied")
 	}
 
-	_, err := ctrl.col.ReplaceDocument(ctx, chat.GetUuid(), chat)
-	return err
-}
+	fields, err := MaskedFields(mask, map[string]interface{}{
+		"title": chat.GetTitle(),
+	})
+	if err != nil {
+		return nil, err
+	}
 
-func (ctrl *ChatsController) InviteUser(ctx context.Context, invite *pb.InviteChatRequest) error {
-	logger := ctrl.log.Named("InviteUser")
-	logger.Info("Inviting user to chat", zap.String("chat", invite.GetChatUuid()), zap.String("user", invite.GetUserUuid()))
+	old := &pb.Chat{}
+	_, err = ctrl.col.ReadDocument(ctx, chat.GetUuid(), old)
+	if err != nil {
+		return nil, err
+	}
+	if old.GetDeletedAt() != 0 {
+		return nil, status.Error(codes.NotFound, "Chat not found")
+	}
 
-	if !HasAccess(ctx, ctrl.db, schema.ACC2CHTS, invite.GetChatUuid(), access.READ) {
-		return status.Error(codes.PermissionDenied, "Permission Denied")
+	if chat.GetRev() != "" {
+		ctx = driver.WithRevision(ctx, chat.GetRev())
+	}
+	updated := &pb.Chat{}
+	meta, err := ctrl.col.UpdateDocument(driver.WithReturnNew(ctx, updated), chat.GetUuid(), fields)
+	if driver.IsPreconditionFailed(err) {
+		return nil, status.Error(codes.Aborted, "Chat has been modified concurrently")
+	}
+	if err != nil {
+		return nil, err
 	}
+	updated.Uuid = meta.ID.Key()
+	updated.Rev = meta.Rev
+
+	return &Chat{updated, meta}, nil
+}
 
-	_, err := ctrl.acc2chts.CreateDocument(ctx, nograph.Access{
-		From:  driver.NewDocumentID(noschema.ACCOUNTS_COL, invite.GetUserUuid()),
-		To:    driver.NewDocumentID(schema.CHATS_COL, invite.GetChatUuid()),
-		Level: access.READ,
+const nextSeqQuery = `
+FOR chat IN @@collection
+    FILTER chat._key == @chat && !chat.deleted_at
+    UPDATE chat WITH { last_seq: NOT_NULL(chat.last_seq, 0) + 1, last_activity: @now } IN @@collection
+        OPTIONS { exclusive: true }
+    RETURN NEW.last_seq`
+
+// NextSeq reserves next message sequence number in the chat and bumps its last activity time
+func (ctrl *ChatsController) NextSeq(ctx context.Context, id string, now int64) (int64, error) {
+	c, err := ctrl.db.Query(ctx, nextSeqQuery, map[string]interface{}{
+		"@collection": schema.CHATS_COL,
+		"chat":        id,
+		"now":         now,
 	})
+	if err != nil {
+		return 0, err
+	}
+	defer c.Close()
 
-	return err
+	var seq int64
+	_, err = c.ReadDocument(ctx, &seq)
+	if driver.IsNoMoreDocuments(err) {
+		return 0, status.Error(codes.NotFound, "Chat not found")
+	}
+	return seq, err
 }
 
+const (
+	DefaultChatsPageSize = 50
+	MaxChatsPageSize     = 200
+)
+
+var listChatsQuery = `
+FOR chat, edge IN 1 OUTBOUND @account @@edges
+    FILTER chat != null && !chat.deleted_at && edge.level >= @level
+    FILTER @title == "" || CONTAINS(LOWER(chat.title), LOWER(@title))
+    LET activity = NOT_NULL(chat.last_activity, 0)
+    %s
+    SORT activity %s, chat._key %s
+    LIMIT @limit
+    LET last_message = FIRST(
+        FOR message IN @@messages
+            FILTER message.to == chat._key
+            SORT message.seq DESC
+            LIMIT 1
+            RETURN MERGE(message, { uuid: message._key, rev: message._rev })
+    )
+    LET unread = LENGTH(
+        FOR message IN @@messages
+            FILTER message.to == chat._key
+            FILTER message.seq > NOT_NULL(edge.read_seq, 0) && message.from != @requestor && !message.deleted_at
+            RETURN 1
+    )
+    RETURN {
+        chat: MERGE(chat, { uuid: chat._key, rev: chat._rev, last_activity: activity }),
+        access_level: edge.level, role: edge.role,
+        last_message, unread, read_seq: NOT_NULL(edge.read_seq, 0)
+    }`
+
+var listChatsCursorFilter = `FILTER activity %[1]s @activity || (activity == @activity && chat._key %[1]s @key)`
+
+// List Chats requestor has access to, most recently active first by default
+func (ctrl *ChatsController) List(ctx context.Context, req *pb.ListChatsRequest) (*pb.ListChatsResponse, error) {
+	logger := ctrl.log.Named("ListChats")
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
+	logger.Info("Listing chats", zap.String("account", requestor), zap.String("cursor", req.GetCursor()))
+
+	limit := req.GetLimit()
+	if limit <= 0 {
+		limit = DefaultChatsPageSize
+	} else if limit > MaxChatsPageSize {
+		limit = MaxChatsPageSize
+	}
+
+	op, order := "<", "DESC"
+	if req.GetOrder() == pb.ListChatsRequest_OLDEST {
+		op, order = ">", "ASC"
+	}
+
+	vars := map[string]interface{}{
+		"account":   driver.NewDocumentID(noschema.ACCOUNTS_COL, requestor),
+		"requestor": requestor,
+		"@edges":    schema.ACC2CHTS,
+		"@messages": schema.CHATS_MESSAGES_COL,
+		"level":     access.READ,
+		"title":     req.GetTitle(),
+		"limit":     limit + 1,
+	}
+	filter := ""
+	if req.GetCursor() != "" {
+		cursor, err := DecodeCursor(req.GetCursor())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Invalid cursor")
+		}
+		filter = fmt.Sprintf(listChatsCursorFilter, op)
+		vars["activity"] = cursor.Value
+		vars["key"] = cursor.Key
+	}
+
+	c, err := ctrl.db.Query(ctx, fmt.Sprintf(listChatsQuery, filter, order, order), vars)
+	if err != nil {
+		logger.Error("Failed to list chats", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Failed to list chats")
+	}
+	defer c.Close()
+
+	chats := []*pb.ChatListItem{}
+	for {
+		item := &pb.ChatListItem{}
+		_, err := c.ReadDocument(ctx, item)
+		if err != nil {
+			if driver.IsNoMoreDocuments(err) {
+				break
+			}
+			logger.Error("Failed to fetch chats", zap.Error(err))
+			return nil, status.Error(codes.Internal, "Failed to fetch chats")
+		}
+		RenderDeleted(item.LastMessage)
+		chats = append(chats, item)
+	}
+
+	res := &pb.ListChatsResponse{}
+	if len(chats) > int(limit) {
+		chats = chats[:limit]
+		res.HasMore = true
+	}
+	if len(chats) > 0 {
+		last := chats[len(chats)-1].GetChat()
+		res.NextCursor = EncodeCursor(&Cursor{Value: last.GetLastActivity(), Key: last.GetUuid()})
+	} else {
+		res.NextCursor = req.GetCursor()
+	}
+	res.Chats = chats
+
+	return res, nil
+}
+
+// Create message in the chat, if message is a reply thread parent is updated along with it
 func (ctrl *ChatsMessagesController) Create(ctx context.Context, msg *pb.ChatMessage, entities []string) (*ChatMessage, error) {
 	logger := ctrl.log.Named("CreateChatMessage")
 	logger.Info("Creating message", zap.Any("message", msg))
 	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
 
 	msg.From = requestor
+	msg.EditedAt, msg.Edited = 0, false
+	msg.DeletedAt, msg.DeletedBy = 0, ""
+	msg.Uuid, msg.Rev = "", ""
+	msg.ReplyCount, msg.LastReplyAt = 0, 0
+	msg.Reactions = nil
 
 	if !HasAccess(ctx, ctrl.db, schema.ACC2CHTS, msg.GetTo(), access.READ) {
 		return nil, status.Error(codes.PermissionDenied, "Permission Denied")
 	}
 
-	meta, err := ctrl.col.CreateDocument(ctx, msg)
+	if msg.GetReplyTo() != "" {
+		root, err := ctrl.threadRoot(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+		msg.ReplyTo = root
+	}
+
+	md, err := ctrl.FetchEntities(ctx, entities)
 	if err != nil {
 		return nil, err
 	}
+
+	// Concurrent sends to the chat conflict on its seq, losing transaction is run again
+	var meta driver.DocumentMeta
+	err = retryConflicts(ctx, func() error {
+		meta, err = ctrl.create(ctx, msg, md)
+		return err
+	})
+	if status.Code(err) == codes.NotFound {
+		return nil, err
+	}
+	if err != nil {
+		logger.Error("Failed to create message", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Failed to create message")
+	}
+
+	return &ChatMessage{msg, meta}, nil
+}
+
+// create stores message with the next seq of the chat and its outbox record in one transaction.
+// Thread parent replies count is bumped in it too, its update is put to the outbox after the reply,
+// so subscribers don't see the count change before the reply itself.
+// Seq is reserved in the same transaction as the message is stored,
+// so sequence has no holes and messages are committed in seq order
+func (ctrl *ChatsMessagesController) create(ctx context.Context, msg *pb.ChatMessage, md map[string]*structpb.Value) (driver.DocumentMeta, error) {
+	logger := ctrl.log.Named("CreateChatMessage")
+	var meta driver.DocumentMeta
+
+	tid, err := ctrl.db.BeginTransaction(ctx, driver.TransactionCollections{
+		Write: []string{schema.CHATS_COL, schema.CHATS_MESSAGES_COL, schema.ACC2MSG, schema.CHATS_OUTBOX_COL},
+	}, nil)
+	if err != nil {
+		return meta, err
+	}
+	trCtx := driver.WithTransactionID(ctx, tid)
+
+	abort := func(err error) (driver.DocumentMeta, error) {
+		if err := ctrl.db.AbortTransaction(ctx, tid, nil); err != nil {
+			logger.Warn("Failed to abort transaction", zap.Error(err))
+		}
+		return meta, err
+	}
+
+	msg.Uuid, msg.Rev, msg.Meta = "", "", nil
+	msg.SentAt = time.Now().UnixMilli()
+	msg.Seq, err = ctrl.cht_ctrl.NextSeq(trCtx, msg.GetTo(), msg.SentAt)
+	if err != nil {
+		return abort(err)
+	}
+
+	meta, err = ctrl.col.CreateDocument(trCtx, msg)
+	if err != nil {
+		return abort(err)
+	}
 	msg.Uuid = meta.ID.Key()
+	msg.Rev = meta.Rev
 
-	_, err = ctrl.acc2msg.CreateDocument(ctx, nograph.Access{
-		From:  driver.NewDocumentID(noschema.ACCOUNTS_COL, requestor),
+	_, err = ctrl.acc2msg.CreateDocument(trCtx, nograph.Access{
+		From:  driver.NewDocumentID(noschema.ACCOUNTS_COL, msg.GetFrom()),
 		To:    driver.NewDocumentID(schema.CHATS_MESSAGES_COL, msg.Uuid),
 		Level: access.MGMT,
 	})
 	if err != nil {
-		logger.Warn("Could not link account and message", zap.String("account", requestor), zap.String("message", msg.Uuid))
+		logger.Warn("Could not link account and message", zap.String("account", msg.GetFrom()), zap.String("message", msg.Uuid))
 	}
+	msg.Meta = md
 
-	md, err := ctrl.FetchEntities(ctx, entities)
+	record, err := NewOutboxRecord(&pb.ChatEvent{
+		Type:     pb.ChatEvent_MESSAGE_CREATED,
+		Payload:  &pb.ChatEvent_Message{Message: msg},
+		ChatUuid: msg.GetTo(),
+		At:       msg.GetSentAt(),
+	})
 	if err != nil {
-		return nil, err
+		return abort(err)
+	}
+	if _, err := ctrl.outbox.CreateDocument(trCtx, record); err != nil {
+		return abort(err)
 	}
-	msg.Meta = md
 
-	return &ChatMessage{msg, meta}, nil
+	if msg.GetReplyTo() != "" {
+		parent, err := ctrl.bumpThread(trCtx, msg.GetReplyTo(), msg.SentAt)
+		if err != nil {
+			logger.Error("Could not update thread", zap.String("parent", msg.GetReplyTo()), zap.Error(err))
+			return abort(err)
+		}
+		record, err := NewOutboxRecord(&pb.ChatEvent{
+			Type:     pb.ChatEvent_MESSAGE_UPDATED,
+			Payload:  &pb.ChatEvent_Message{Message: parent.ChatMessage},
+			ChatUuid: parent.GetTo(),
+			At:       msg.GetSentAt(),
+		})
+		if err != nil {
+			return abort(err)
+		}
+		if _, err := ctrl.outbox.CreateDocument(trCtx, record); err != nil {
+			return abort(err)
+		}
+	}
+
+	return meta, ctrl.db.CommitTransaction(ctx, tid, nil)
 }
 
 func (ctrl *ChatsMessagesController) Get(ctx context.Context, id string) (*ChatMessage, error) {
@@ -205,57 +579,274 @@ func (ctrl *ChatsMessagesController) Get(ctx context.Context, id string) (*ChatM
 	}
 
 	msg.Uuid = meta.ID.Key()
+	msg.Rev = meta.Rev
+	RenderDeleted(msg)
+
+	if err := ctrl.WithReactions(ctx, msg); err != nil {
+		return nil, err
+	}
 	return &ChatMessage{msg, meta}, nil
 }
 
-func (ctrl *ChatsMessagesController) Delete(ctx context.Context, id string) error {
+// Delete marks message as deleted, it's purged after retention period
+func (ctrl *ChatsMessagesController) Delete(ctx context.Context, id string) (*ChatMessage, error) {
 	logger := ctrl.log.Named("DeleteChatMessage")
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
 	logger.Info("Deleting message", zap.String("id", id))
 
 	if !HasAccess(ctx, ctrl.db, schema.ACC2MSG, id, access.MGMT) {
-		return status.Error(codes.PermissionDenied, "Permission Denied")
+		return nil, status.Error(codes.PermissionDenied, "Permission Denied")
 	}
-	_, err := ctrl.col.RemoveDocument(ctx, id)
-	return err
+
+	old := &pb.ChatMessage{}
+	_, err := ctrl.col.ReadDocument(ctx, id, old)
+	if err != nil {
+		return nil, err
+	}
+	if old.GetDeletedAt() != 0 {
+		return nil, status.Error(codes.NotFound, "Message not found")
+	}
+
+	msg := &pb.ChatMessage{}
+	meta, err := ctrl.col.UpdateDocument(driver.WithReturnNew(ctx, msg), id, map[string]interface{}{
+		"deleted_at": time.Now().UnixMilli(),
+		"deleted_by": requestor,
+	})
+	if err != nil {
+		return nil, err
+	}
+	msg.Uuid = meta.ID.Key()
+	msg.Rev = meta.Rev
+	RenderDeleted(msg)
+
+	return &ChatMessage{msg, meta}, nil
 }
 
-func (ctrl *ChatsMessagesController) Update(ctx context.Context, msg *pb.ChatMessage) error {
+// Restore deleted message, allowed to its author and chat owners
+func (ctrl *ChatsMessagesController) Restore(ctx context.Context, id string) (*ChatMessage, error) {
+	logger := ctrl.log.Named("RestoreChatMessage")
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
+	logger.Info("Restoring message", zap.String("id", id))
+
+	old := &pb.ChatMessage{}
+	_, err := ctrl.col.ReadDocument(ctx, id, old)
+	if err != nil {
+		return nil, err
+	}
+
+	if !HasAccess(ctx, ctrl.db, schema.ACC2MSG, id, access.MGMT) {
+		member, err := ctrl.cht_ctrl.GetMember(ctx, old.GetTo(), requestor)
+		if err != nil || member.Role != roles.OWNER {
+			return nil, status.Error(codes.PermissionDenied, "Permission Denied")
+		}
+	}
+
+	msg := &pb.ChatMessage{}
+	meta, err := ctrl.col.UpdateDocument(driver.WithKeepNull(driver.WithReturnNew(ctx, msg), false), id, map[string]interface{}{
+		"deleted_at": nil,
+		"deleted_by": nil,
+	})
+	if err != nil {
+		return nil, err
+	}
+	msg.Uuid = meta.ID.Key()
+	msg.Rev = meta.Rev
+
+	if err := ctrl.WithReactions(ctx, msg); err != nil {
+		return nil, err
+	}
+	return &ChatMessage{msg, meta}, nil
+}
+
+// Previous version goes to revisions, only message body and meta can be updated
+const updateMessageQuery = `
+FOR msg IN @@messages
+    FILTER msg._key == @key && !msg.deleted_at
+    FILTER @rev == "" || msg._rev == @rev
+    INSERT {
+        message_uuid: msg._key, chat: msg.to,
+        message: msg.message, meta: msg.meta,
+        edited_by: @editor, edited_at: @now
+    } INTO @@revisions
+    UPDATE msg WITH MERGE(@fields, { edited: true, edited_at: @now }) IN @@messages
+        OPTIONS { exclusive: true, mergeObjects: false }
+    RETURN NEW`
+
+// Update message fields listed in mask, if message revision is given it must match the stored one
+func (ctrl *ChatsMessagesController) Update(ctx context.Context, msg *pb.ChatMessage, mask *fieldmaskpb.FieldMask) (*ChatMessage, error) {
 	logger := ctrl.log.Named("UpdateChatMessage")
-	logger.Info("Updating message", zap.String("id", msg.GetUuid()), zap.Any("message", msg))
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
+	logger.Info("Updating message", zap.String("id", msg.GetUuid()), zap.Any("message", msg), zap.Strings("mask", mask.GetPaths()))
 
 	if !HasAccess(ctx, ctrl.db, schema.ACC2MSG, msg.GetUuid(), access.MGMT) {
-		return status.Error(codes.PermissionDenied, "Permission Denied")
+		return nil, status.Error(codes.PermissionDenied, "Permission Denied")
 	}
 
-	_, err := ctrl.col.ReplaceDocument(ctx, msg.GetUuid(), msg)
-	return err
+	fields, err := MaskedFields(mask, map[string]interface{}{
+		"message": msg.GetMessage(),
+		"meta":    msg.GetMeta(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := ctrl.db.Query(ctx, updateMessageQuery, map[string]interface{}{
+		"@messages":  schema.CHATS_MESSAGES_COL,
+		"@revisions": schema.CHATS_REVISIONS_COL,
+		"key":        msg.GetUuid(),
+		"rev":        msg.GetRev(),
+		"editor":     requestor,
+		"now":        time.Now().UnixMilli(),
+		"fields":     fields,
+	})
+	if err != nil {
+		logger.Error("Failed to update message", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Failed to update message")
+	}
+	defer c.Close()
+
+	updated := &pb.ChatMessage{}
+	meta, err := c.ReadDocument(ctx, updated)
+	if driver.IsNoMoreDocuments(err) {
+		return nil, ctrl.updateMissed(ctx, msg.GetUuid())
+	}
+	if err != nil {
+		return nil, err
+	}
+	updated.Uuid = meta.ID.Key()
+	updated.Rev = meta.Rev
+
+	if err := ctrl.WithReactions(ctx, updated); err != nil {
+		return nil, err
+	}
+	return &ChatMessage{updated, meta}, nil
+}
+
+// updateMissed tells why update query didn't match the message
+func (ctrl *ChatsMessagesController) updateMissed(ctx context.Context, id string) error {
+	msg := &pb.ChatMessage{}
+	_, err := ctrl.col.ReadDocument(ctx, id, msg)
+	if driver.IsNotFound(err) || (err == nil && msg.GetDeletedAt() != 0) {
+		return status.Error(codes.NotFound, "Message not found")
+	}
+	if err != nil {
+		return err
+	}
+	return status.Error(codes.Aborted, "Message has been modified concurrently")
+}
+
+const listRevisionsQuery = `
+FOR rev IN @@collection
+    FILTER rev.message_uuid == @message
+    SORT rev.edited_at DESC
+    RETURN MERGE(rev, { uuid: rev._key })`
+
+// ListRevisions returns previous versions of the message, newest first
+func (ctrl *ChatsMessagesController) ListRevisions(ctx context.Context, id string) ([]*pb.ChatMessageRevision, error) {
+	logger := ctrl.log.Named("ListMessageRevisions")
+	logger.Info("Listing message revisions", zap.String("id", id))
+
+	msg := &pb.ChatMessage{}
+	_, err := ctrl.col.ReadDocument(ctx, id, msg)
+	if driver.IsNotFound(err) {
+		return nil, status.Error(codes.NotFound, "Message not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !HasAccess(ctx, ctrl.db, schema.ACC2MSG, id, access.MGMT) &&
+		!HasAccess(ctx, ctrl.db, schema.ACC2CHTS, msg.GetTo(), access.MGMT) {
+		return nil, status.Error(codes.PermissionDenied, "Permission Denied")
+	}
+
+	c, err := ctrl.db.Query(ctx, listRevisionsQuery, map[string]interface{}{
+		"@collection": schema.CHATS_REVISIONS_COL,
+		"message":     id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	revisions := []*pb.ChatMessageRevision{}
+	for {
+		rev := &pb.ChatMessageRevision{}
+		_, err := c.ReadDocument(ctx, rev)
+		if err != nil {
+			if driver.IsNoMoreDocuments(err) {
+				break
+			}
+			logger.Error("Failed to fetch message revisions", zap.Error(err))
+			return nil, status.Error(codes.Internal, "Failed to fetch message revisions")
+		}
+		revisions = append(revisions, rev)
+	}
+
+	return revisions, nil
 }
 
+const (
+	DefaultMessagesPageSize = 50
+	MaxMessagesPageSize     = 500
+)
+
 var listQuery = `
 FOR message IN @@collection 
     FILTER message.to == @chat 
+    %s
+    SORT message.seq %s, message._key %s
+    LIMIT @limit
     RETURN message`
 
-func (ctrl *ChatsMessagesController) List(ctx context.Context, req *pb.ListChatMessagesRequest) ([]*pb.ChatMessage, error) {
+var listCursorFilter = `FILTER message.seq %[1]s @seq || (message.seq == @seq && message._key %[1]s @key)`
+
+func (ctrl *ChatsMessagesController) List(ctx context.Context, req *pb.ListChatMessagesRequest) (*pb.ListChatMessagesResponse, error) {
 	logger := ctrl.log.Named("ListChatMessages")
-	logger.Info("Fetching messages", zap.String("chat", req.GetChatUuid()))
+	logger.Info("Fetching messages", zap.String("chat", req.GetChatUuid()), zap.String("cursor", req.GetCursor()))
 
-	if !HasAccess(ctx, ctrl.db, schema.ACC2CHTS, req.GetChatUuid(), access.READ) {
-		return nil, status.Error(codes.PermissionDenied, "Permission Denied")
+	if err := ChatAccess(ctx, ctrl.db, req.GetChatUuid(), access.READ); err != nil {
+		return nil, err
+	}
+
+	limit := req.GetLimit()
+	if limit <= 0 {
+		limit = DefaultMessagesPageSize
+	} else if limit > MaxMessagesPageSize {
+		limit = MaxMessagesPageSize
+	}
+
+	op, order := "<", "DESC"
+	if req.GetDirection() == pb.ListChatMessagesRequest_NEWER {
+		op, order = ">", "ASC"
 	}
 
-	c, err := ctrl.db.Query(ctx, listQuery, map[string]interface{}{
+	vars := map[string]interface{}{
 		"chat":        req.GetChatUuid(),
 		"@collection": schema.CHATS_MESSAGES_COL,
-	})
+		"limit":       limit + 1,
+	}
+	filter := ""
+	if req.GetCursor() != "" {
+		cursor, err := DecodeCursor(req.GetCursor())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Invalid cursor")
+		}
+		filter = fmt.Sprintf(listCursorFilter, op)
+		vars["seq"] = cursor.Value
+		vars["key"] = cursor.Key
+	}
+
+	c, err := ctrl.db.Query(ctx, fmt.Sprintf(listQuery, filter, order, order), vars)
 	if err != nil {
 		return nil, err
 	}
+	defer c.Close()
 
 	messages := []*pb.ChatMessage{}
 	for {
 		message := &pb.ChatMessage{}
-		_, err = c.ReadDocument(ctx, message)
+		meta, err := c.ReadDocument(ctx, message)
 
 		if err != nil {
 			if driver.IsNoMoreDocuments(err) {
@@ -264,9 +855,36 @@ func (ctrl *ChatsMessagesController) List(ctx context.Context, req *pb.ListChatM
 			ctrl.log.Error("Failed to fetch messages from chat", zap.Error(err))
 			return nil, status.Error(codes.Internal, "Failed to fetch messages from chat")
 		} else {
+			message.Uuid = meta.ID.Key()
+			message.Rev = meta.Rev
+			RenderDeleted(message)
 			messages = append(messages, message)
 		}
 	}
 
-	return messages, nil
+	res := &pb.ListChatMessagesResponse{}
+	if len(messages) > int(limit) {
+		messages = messages[:limit]
+		res.HasMore = true
+	}
+	if len(messages) > 0 {
+		last := messages[len(messages)-1]
+		res.NextCursor = EncodeCursor(&Cursor{Value: last.Seq, Key: last.Uuid})
+	} else {
+		res.NextCursor = req.GetCursor()
+	}
+
+	if err := ctrl.WithReactions(ctx, messages...); err != nil {
+		return nil, err
+	}
+
+	// Pages are always returned in chronological order
+	if order == "DESC" {
+		for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+			messages[i], messages[j] = messages[j], messages[i]
+		}
+	}
+	res.Messages = messages
+
+	return res, nil
 }
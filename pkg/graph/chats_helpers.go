@@ -1 +1,159 @@
 package graph
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/arangodb/go-driver"
+	pb "github.com/slntopp/nocloud-cc/cc"
+	"github.com/slntopp/nocloud-cc/pkg/schema"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Cursor points at the last document of a page.
+// Documents are ordered by Value (message seq, chat activity) with _key as a tie-breaker
+type Cursor struct {
+	Value int64  `json:"v"`
+	Key   string `json:"k"`
+}
+
+func EncodeCursor(cursor *Cursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodeCursor(token string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, err
+	}
+	cursor := &Cursor{}
+	err = json.Unmarshal(data, cursor)
+	return cursor, err
+}
+
+const DeletedMessagePlaceholder = "message deleted"
+
+// RenderDeleted strips deleted message content, leaving tombstone only
+func RenderDeleted(msg *pb.ChatMessage) {
+	if msg == nil || msg.GetDeletedAt() == 0 {
+		return
+	}
+	msg.Message = DeletedMessagePlaceholder
+	msg.Meta = nil
+}
+
+// MaskedFields picks the fields listed in mask out of mutable ones, empty mask means every
+// mutable field. Nested paths (e.g. meta.key) update the whole top-level field
+func MaskedFields(mask *fieldmaskpb.FieldMask, mutable map[string]interface{}) (map[string]interface{}, error) {
+	if len(mask.GetPaths()) == 0 {
+		return mutable, nil
+	}
+	fields := make(map[string]interface{})
+	for _, path := range mask.GetPaths() {
+		field := strings.SplitN(path, ".", 2)[0]
+		value, ok := mutable[field]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "Field %s can't be updated", path)
+		}
+		fields[field] = value
+	}
+	return fields, nil
+}
+
+const migrateOrderingQuery = `
+FOR message IN @@collection
+    FILTER message.seq == null || message.sent_at == null
+    UPDATE message WITH { seq: NOT_NULL(message.seq, 0), sent_at: NOT_NULL(message.sent_at, 0) } IN @@collection`
+
+// Messages created before seq was introduced have no sort key,
+// they're placed before everything else so cursors stay consistent
+func migrateMessagesOrdering(log *zap.Logger, ctx context.Context, db driver.Database) {
+	c, err := db.Query(ctx, migrateOrderingQuery, map[string]interface{}{
+		"@collection": schema.CHATS_MESSAGES_COL,
+	})
+	if err != nil {
+		log.Warn("Failed to migrate messages ordering", zap.Error(err))
+		return
+	}
+	c.Close()
+}
+
+const dedupeMembersQuery = `
+FOR edge IN @@collection
+    COLLECT from = edge._from, to = edge._to INTO edges = edge
+    FILTER LENGTH(edges) > 1
+    LET keep = FIRST(FOR e IN edges SORT e.level DESC RETURN e._key)
+    FOR e IN edges
+        FILTER e._key != keep
+        REMOVE e IN @@collection`
+
+// InviteUser used to create an edge on every call, only the edge with the highest
+// access level is kept, so Accounts2Chats can be uniquely indexed
+func dedupeMembers(log *zap.Logger, ctx context.Context, db driver.Database) {
+	c, err := db.Query(ctx, dedupeMembersQuery, map[string]interface{}{
+		"@collection": schema.ACC2CHTS,
+	})
+	if err != nil {
+		log.Warn("Failed to dedupe chats members", zap.Error(err))
+		return
+	}
+	c.Close()
+}
+
+// ChatAccess checks requestor has given access level to the Chat.
+// Deleted Chats are not found with everything in them until restored
+func ChatAccess(ctx context.Context, db driver.Database, chat string, level int32) error {
+	if !HasAccess(ctx, db, schema.ACC2CHTS, chat, level) {
+		return status.Error(codes.PermissionDenied, "Permission Denied")
+	}
+	return LiveChat(ctx, db, chat)
+}
+
+// LiveChat returns NotFound if Chat doesn't exist or is deleted
+func LiveChat(ctx context.Context, db driver.Database, chat string) error {
+	col, err := db.Collection(ctx, schema.CHATS_COL)
+	if err != nil {
+		return err
+	}
+	c := &pb.Chat{}
+	_, err = col.ReadDocument(ctx, chat, c)
+	if driver.IsNotFound(err) || (err == nil && c.GetDeletedAt() != 0) {
+		return status.Error(codes.NotFound, "Chat not found")
+	}
+	return err
+}
+
+// Transactions run by retryConflicts at most, delay before the next attempt grows linearly
+const (
+	MaxTransactionAttempts = 10
+	TransactionRetryDelay  = 5 * time.Millisecond
+)
+
+// retryConflicts runs fn again while it fails with write-write conflict, which ArangoDB
+// reports instead of waiting for the concurrent transaction holding the document
+func retryConflicts(ctx context.Context, fn func() error) error {
+	var err error
+	for attempt := 1; attempt <= MaxTransactionAttempts; attempt++ {
+		err = fn()
+		if !driver.IsArangoErrorWithErrorNum(err, driver.ErrArangoConflict) {
+			return err
+		}
+		delay := time.Duration(attempt) * TransactionRetryDelay
+		// Jitter keeps conflicting transactions from retrying in lockstep
+		delay += time.Duration(rand.Int63n(int64(TransactionRetryDelay)))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+	return err
+}
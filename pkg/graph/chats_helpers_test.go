@@ -0,0 +1,92 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/arangodb/go-driver"
+)
+
+// seqStore mimics chat document updated in a stream transaction: transaction
+// which read the seq fails with write-write conflict if another one committed meanwhile
+type seqStore struct {
+	mu       sync.Mutex
+	seq      int64
+	messages []int64
+}
+
+func (s *seqStore) send() (int64, error) {
+	s.mu.Lock()
+	read := s.seq
+	s.mu.Unlock()
+
+	// Transaction stays open while message and outbox record are stored
+	time.Sleep(time.Millisecond)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.seq != read {
+		return 0, driver.ArangoError{HasError: true, Code: 409, ErrorNum: driver.ErrArangoConflict, ErrorMessage: "write-write conflict"}
+	}
+	s.seq = read + 1
+	s.messages = append(s.messages, s.seq)
+	return s.seq, nil
+}
+
+func TestRetryConflictsConcurrentSends(t *testing.T) {
+	store := &seqStore{}
+	const senders = 5
+
+	var wg sync.WaitGroup
+	errs := make(chan error, senders)
+	for i := 0; i < senders; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- retryConflicts(context.Background(), func() error {
+				_, err := store.send()
+				return err
+			})
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("send failed: %v", err)
+		}
+	}
+	if len(store.messages) != senders {
+		t.Fatalf("stored %d messages, expected %d", len(store.messages), senders)
+	}
+	for i, seq := range store.messages {
+		if seq != int64(i+1) {
+			t.Fatalf("message %d has seq %d, sequence must have no holes", i, seq)
+		}
+	}
+}
+
+func TestRetryConflictsOtherErrors(t *testing.T) {
+	failure := errors.New("failure")
+	calls := 0
+	err := retryConflicts(context.Background(), func() error {
+		calls++
+		return failure
+	})
+	if !errors.Is(err, failure) || calls != 1 {
+		t.Fatalf("expected single failed call, got %d calls and %v", calls, err)
+	}
+
+	calls = 0
+	err = retryConflicts(context.Background(), func() error {
+		calls++
+		return driver.ArangoError{HasError: true, Code: 409, ErrorNum: driver.ErrArangoConflict}
+	})
+	if !driver.IsArangoErrorWithErrorNum(err, driver.ErrArangoConflict) || calls != MaxTransactionAttempts {
+		t.Fatalf("expected %d conflicting calls, got %d and %v", MaxTransactionAttempts, calls, err)
+	}
+}
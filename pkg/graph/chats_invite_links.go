@@ -0,0 +1,250 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/arangodb/go-driver"
+	"github.com/golang-jwt/jwt/v4"
+	pb "github.com/slntopp/nocloud-cc/cc"
+	"github.com/slntopp/nocloud-cc/pkg/schema"
+	"github.com/slntopp/nocloud/pkg/nocloud"
+	"github.com/slntopp/nocloud/pkg/nocloud/access"
+	"github.com/slntopp/nocloud/pkg/nocloud/auth"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	linkClaim = "link"
+	chatClaim = "chat"
+)
+
+// Link tokens only reference the link document, signing them makes sure
+// nobody can redeem links by guessing sequential document keys
+func makeLinkToken(link *pb.ChatInviteLink) (string, error) {
+	claims := jwt.MapClaims{}
+	claims[linkClaim] = link.GetUuid()
+	claims[chatClaim] = link.GetChat()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(auth.SIGNING_KEY)
+}
+
+func parseLinkToken(tokenString string) (string, error) {
+	token, err := jwt.Parse(tokenString, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+		return auth.SIGNING_KEY, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return "", errors.New("invalid token")
+	}
+	link, ok := claims[linkClaim].(string)
+	if !ok || link == "" {
+		return "", errors.New("not an invite link token")
+	}
+	return link, nil
+}
+
+func (ctrl *ChatsController) CreateInviteLink(ctx context.Context, req *pb.CreateInviteLinkRequest) (*pb.ChatInviteLink, error) {
+	logger := ctrl.log.Named("CreateInviteLink")
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
+	logger.Info("Creating invite link", zap.String("chat", req.GetChatUuid()), zap.String("account", requestor))
+
+	own, err := ctrl.GetMember(ctx, req.GetChatUuid(), requestor)
+	if err != nil || own.Level < InviteAccessLevel {
+		return nil, status.Error(codes.PermissionDenied, "Permission Denied")
+	}
+	if err := LiveChat(ctx, ctrl.db, req.GetChatUuid()); err != nil {
+		return nil, err
+	}
+
+	level := req.GetAccessLevel()
+	if level == access.NONE {
+		level = access.READ
+	}
+	if level < access.READ || level > own.Level || level > access.MGMT {
+		return nil, status.Error(codes.InvalidArgument, "Can't grant this access level")
+	}
+	now := time.Now().UnixMilli()
+	if req.GetExpiresAt() != 0 && req.GetExpiresAt() <= now {
+		return nil, status.Error(codes.InvalidArgument, "Link expiry must be in the future")
+	}
+	if req.GetMaxUses() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Max uses can't be negative")
+	}
+
+	link := &pb.ChatInviteLink{
+		Chat:        req.GetChatUuid(),
+		CreatedBy:   requestor,
+		AccessLevel: level,
+		ExpiresAt:   req.GetExpiresAt(),
+		MaxUses:     req.GetMaxUses(),
+		CreatedAt:   now,
+	}
+	meta, err := ctrl.links.CreateDocument(ctx, link)
+	if err != nil {
+		return nil, err
+	}
+	link.Uuid = meta.ID.Key()
+
+	link.Token, err = makeLinkToken(link)
+	if err != nil {
+		logger.Error("Failed to sign invite link", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Failed to sign invite link")
+	}
+
+	return link, nil
+}
+
+const listLinksQuery = `
+FOR link IN @@collection
+    FILTER link.chat == @chat
+    SORT link.created_at DESC
+    RETURN MERGE(link, { uuid: link._key })`
+
+func (ctrl *ChatsController) ListInviteLinks(ctx context.Context, chat string) ([]*pb.ChatInviteLink, error) {
+	logger := ctrl.log.Named("ListInviteLinks")
+	logger.Info("Listing invite links", zap.String("chat", chat))
+
+	if !HasAccess(ctx, ctrl.db, schema.ACC2CHTS, chat, InviteAccessLevel) {
+		return nil, status.Error(codes.PermissionDenied, "Permission Denied")
+	}
+
+	c, err := ctrl.db.Query(ctx, listLinksQuery, map[string]interface{}{
+		"@collection": schema.CHATS_LINKS_COL,
+		"chat":        chat,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	links := []*pb.ChatInviteLink{}
+	for {
+		link := &pb.ChatInviteLink{}
+		_, err := c.ReadDocument(ctx, link)
+		if err != nil {
+			if driver.IsNoMoreDocuments(err) {
+				break
+			}
+			logger.Error("Failed to fetch invite links", zap.Error(err))
+			return nil, status.Error(codes.Internal, "Failed to fetch invite links")
+		}
+		link.Token, err = makeLinkToken(link)
+		if err != nil {
+			logger.Error("Failed to sign invite link", zap.Error(err))
+			return nil, status.Error(codes.Internal, "Failed to sign invite link")
+		}
+		links = append(links, link)
+	}
+
+	return links, nil
+}
+
+func (ctrl *ChatsController) RevokeInviteLink(ctx context.Context, id string) error {
+	logger := ctrl.log.Named("RevokeInviteLink")
+	logger.Info("Revoking invite link", zap.String("link", id))
+
+	link := &pb.ChatInviteLink{}
+	_, err := ctrl.links.ReadDocument(ctx, id, link)
+	if driver.IsNotFound(err) {
+		return status.Error(codes.NotFound, "Invite link not found")
+	}
+	if err != nil {
+		return err
+	}
+
+	if !HasAccess(ctx, ctrl.db, schema.ACC2CHTS, link.GetChat(), InviteAccessLevel) {
+		return status.Error(codes.PermissionDenied, "Permission Denied")
+	}
+
+	_, err = ctrl.links.RemoveDocument(ctx, id)
+	return err
+}
+
+const useLinkQuery = `
+FOR link IN @@collection
+    FILTER link._key == @key
+    FILTER link.expires_at == null || link.expires_at == 0 || link.expires_at > @now
+    FILTER link.max_uses == null || link.max_uses == 0 || NOT_NULL(link.uses, 0) < link.max_uses
+    UPDATE link WITH { uses: NOT_NULL(link.uses, 0) + 1 } IN @@collection
+        OPTIONS { exclusive: true }
+    RETURN MERGE(NEW, { uuid: NEW._key })`
+
+func (ctrl *ChatsController) useInviteLink(ctx context.Context, id string) (*pb.ChatInviteLink, error) {
+	c, err := ctrl.db.Query(ctx, useLinkQuery, map[string]interface{}{
+		"@collection": schema.CHATS_LINKS_COL,
+		"key":         id,
+		"now":         time.Now().UnixMilli(),
+	})
+	if err != nil {
+		ctrl.log.Error("Failed to use invite link", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Failed to use invite link")
+	}
+	defer c.Close()
+
+	link := &pb.ChatInviteLink{}
+	_, err = c.ReadDocument(ctx, link)
+	if driver.IsNoMoreDocuments(err) {
+		return nil, status.Error(codes.FailedPrecondition, "Invite link is expired or used up")
+	}
+	return link, err
+}
+
+// RedeemInviteLink joins requestor to the chat, redeeming link as a member doesn't count as a use.
+// Returns true if requestor wasn't a member before
+func (ctrl *ChatsController) RedeemInviteLink(ctx context.Context, token string) (*Chat, bool, error) {
+	logger := ctrl.log.Named("RedeemInviteLink")
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
+
+	id, err := parseLinkToken(token)
+	if err != nil {
+		logger.Debug("Invalid invite link token", zap.Error(err))
+		return nil, false, status.Error(codes.InvalidArgument, "Invalid invite link")
+	}
+	logger.Info("Redeeming invite link", zap.String("link", id), zap.String("account", requestor))
+
+	link := &pb.ChatInviteLink{}
+	_, err = ctrl.links.ReadDocument(ctx, id, link)
+	if driver.IsNotFound(err) {
+		return nil, false, status.Error(codes.NotFound, "Invite link not found")
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	// Links of deleted chats are kept for restore, but can't be used meanwhile
+	if err := LiveChat(ctx, ctrl.db, link.GetChat()); err != nil {
+		return nil, false, err
+	}
+
+	joined := false
+	_, err = ctrl.GetMember(ctx, link.GetChat(), requestor)
+	switch status.Code(err) {
+	case codes.OK:
+		logger.Debug("Account is already a member", zap.String("chat", link.GetChat()))
+	case codes.NotFound:
+		link, err = ctrl.useInviteLink(ctx, id)
+		if err != nil {
+			return nil, false, err
+		}
+		joined, err = ctrl.AddMember(ctx, link.GetChat(), requestor, link.GetAccessLevel())
+		if err != nil {
+			logger.Error("Failed to add member", zap.Error(err))
+			return nil, false, status.Error(codes.Internal, "Failed to join chat")
+		}
+	default:
+		return nil, false, err
+	}
+
+	chat, err := ctrl.Get(ctx, link.GetChat())
+	return chat, joined, err
+}
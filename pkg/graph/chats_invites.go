@@ -0,0 +1,207 @@
+package graph
+
+import (
+	"context"
+	"time"
+
+	"github.com/arangodb/go-driver"
+	pb "github.com/slntopp/nocloud-cc/cc"
+	"github.com/slntopp/nocloud-cc/pkg/schema"
+	"github.com/slntopp/nocloud/pkg/nocloud"
+	"github.com/slntopp/nocloud/pkg/nocloud/access"
+	noschema "github.com/slntopp/nocloud/pkg/nocloud/schema"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Minimal access level to the chat required to invite new members
+var InviteAccessLevel = access.MGMT
+
+// InviteUser creates pending invite, inviting existing members or accounts
+// with pending invites is a no-op and returns no invite
+func (ctrl *ChatsController) InviteUser(ctx context.Context, req *pb.InviteChatRequest) (*pb.ChatInvite, error) {
+	logger := ctrl.log.Named("InviteUser")
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
+	logger.Info("Inviting user to chat", zap.String("chat", req.GetChatUuid()), zap.String("user", req.GetUserUuid()))
+
+	own, err := ctrl.GetMember(ctx, req.GetChatUuid(), requestor)
+	if err != nil || own.Level < InviteAccessLevel {
+		return nil, status.Error(codes.PermissionDenied, "Permission Denied")
+	}
+	if err := LiveChat(ctx, ctrl.db, req.GetChatUuid()); err != nil {
+		return nil, err
+	}
+
+	level := req.GetAccessLevel()
+	if level == access.NONE {
+		level = access.READ
+	}
+	if level < access.READ || level > own.Level || level > access.MGMT {
+		return nil, status.Error(codes.InvalidArgument, "Can't grant this access level")
+	}
+
+	accounts, err := ctrl.db.Collection(ctx, noschema.ACCOUNTS_COL)
+	if err != nil {
+		return nil, err
+	}
+	exists := false
+	if req.GetUserUuid() != "" {
+		exists, err = accounts.DocumentExists(ctx, req.GetUserUuid())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !exists {
+		return nil, status.Error(codes.NotFound, "Account not found")
+	}
+
+	_, err = ctrl.GetMember(ctx, req.GetChatUuid(), req.GetUserUuid())
+	if err == nil {
+		logger.Debug("Account is already a member", zap.String("user", req.GetUserUuid()))
+		return nil, nil
+	}
+	if status.Code(err) != codes.NotFound {
+		return nil, err
+	}
+
+	invite := &pb.ChatInvite{
+		Chat:        req.GetChatUuid(),
+		From:        requestor,
+		To:          req.GetUserUuid(),
+		AccessLevel: level,
+		CreatedAt:   time.Now().UnixMilli(),
+	}
+	meta, err := ctrl.invites.CreateDocument(ctx, invite)
+	if driver.IsConflict(err) {
+		logger.Debug("Account has pending invite already", zap.String("user", req.GetUserUuid()))
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	invite.Uuid = meta.ID.Key()
+
+	return invite, nil
+}
+
+func (ctrl *ChatsController) GetInvite(ctx context.Context, id string) (*pb.ChatInvite, error) {
+	invite := &pb.ChatInvite{}
+	meta, err := ctrl.invites.ReadDocument(ctx, id, invite)
+	if driver.IsNotFound(err) {
+		return nil, status.Error(codes.NotFound, "Invite not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	invite.Uuid = meta.ID.Key()
+	return invite, nil
+}
+
+const listInvitesQuery = `
+FOR invite IN @@collection
+    FILTER @chat == "" ? invite.to == @requestor : invite.chat == @chat
+    SORT invite.created_at DESC
+    RETURN MERGE(invite, { uuid: invite._key })`
+
+// ListInvites returns pending invites to the chat if given, otherwise invites addressed to requestor
+func (ctrl *ChatsController) ListInvites(ctx context.Context, chat string) ([]*pb.ChatInvite, error) {
+	logger := ctrl.log.Named("ListInvites")
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
+	logger.Info("Listing invites", zap.String("chat", chat), zap.String("account", requestor))
+
+	if chat != "" && !HasAccess(ctx, ctrl.db, schema.ACC2CHTS, chat, InviteAccessLevel) {
+		return nil, status.Error(codes.PermissionDenied, "Permission Denied")
+	}
+
+	c, err := ctrl.db.Query(ctx, listInvitesQuery, map[string]interface{}{
+		"@collection": schema.CHATS_INVITES_COL,
+		"chat":        chat,
+		"requestor":   requestor,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	invites := []*pb.ChatInvite{}
+	for {
+		invite := &pb.ChatInvite{}
+		_, err := c.ReadDocument(ctx, invite)
+		if err != nil {
+			if driver.IsNoMoreDocuments(err) {
+				break
+			}
+			logger.Error("Failed to fetch invites", zap.Error(err))
+			return nil, status.Error(codes.Internal, "Failed to fetch invites")
+		}
+		invites = append(invites, invite)
+	}
+
+	return invites, nil
+}
+
+// AcceptInvite joins requestor to the chat, returns true if requestor wasn't a member before
+func (ctrl *ChatsController) AcceptInvite(ctx context.Context, id string) (*pb.ChatInvite, bool, error) {
+	logger := ctrl.log.Named("AcceptInvite")
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
+	logger.Info("Accepting invite", zap.String("invite", id), zap.String("account", requestor))
+
+	invite, err := ctrl.GetInvite(ctx, id)
+	if err != nil {
+		return nil, false, err
+	}
+	if invite.GetTo() != requestor {
+		return nil, false, status.Error(codes.PermissionDenied, "Invite is addressed to another account")
+	}
+	if err := LiveChat(ctx, ctrl.db, invite.GetChat()); err != nil {
+		return nil, false, err
+	}
+
+	joined, err := ctrl.AddMember(ctx, invite.GetChat(), requestor, invite.GetAccessLevel())
+	if err != nil {
+		logger.Error("Failed to add member", zap.Error(err))
+		return nil, false, status.Error(codes.Internal, "Failed to join chat")
+	}
+
+	_, err = ctrl.invites.RemoveDocument(ctx, id)
+	if err != nil && !driver.IsNotFound(err) {
+		logger.Warn("Failed to remove accepted invite", zap.String("invite", id), zap.Error(err))
+	}
+	return invite, joined, nil
+}
+
+func (ctrl *ChatsController) DeclineInvite(ctx context.Context, id string) error {
+	logger := ctrl.log.Named("DeclineInvite")
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
+	logger.Info("Declining invite", zap.String("invite", id), zap.String("account", requestor))
+
+	invite, err := ctrl.GetInvite(ctx, id)
+	if err != nil {
+		return err
+	}
+	if invite.GetTo() != requestor {
+		return status.Error(codes.PermissionDenied, "Invite is addressed to another account")
+	}
+
+	_, err = ctrl.invites.RemoveDocument(ctx, id)
+	return err
+}
+
+// RevokeInvite can be done by the inviter or anyone allowed to invite to the chat
+func (ctrl *ChatsController) RevokeInvite(ctx context.Context, id string) error {
+	logger := ctrl.log.Named("RevokeInvite")
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
+	logger.Info("Revoking invite", zap.String("invite", id), zap.String("account", requestor))
+
+	invite, err := ctrl.GetInvite(ctx, id)
+	if err != nil {
+		return err
+	}
+	if invite.GetFrom() != requestor && !HasAccess(ctx, ctrl.db, schema.ACC2CHTS, invite.GetChat(), InviteAccessLevel) {
+		return status.Error(codes.PermissionDenied, "Permission Denied")
+	}
+
+	_, err = ctrl.invites.RemoveDocument(ctx, id)
+	return err
+}
@@ -0,0 +1,324 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/arangodb/go-driver"
+	pb "github.com/slntopp/nocloud-cc/cc"
+	"github.com/slntopp/nocloud-cc/pkg/schema"
+	nograph "github.com/slntopp/nocloud/pkg/graph"
+	"github.com/slntopp/nocloud/pkg/nocloud"
+	"github.com/slntopp/nocloud/pkg/nocloud/access"
+	"github.com/slntopp/nocloud/pkg/nocloud/roles"
+	noschema "github.com/slntopp/nocloud/pkg/nocloud/schema"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetMember returns the Accounts2Chats edge between account and chat
+func (ctrl *ChatsController) GetMember(ctx context.Context, chat, account string) (*nograph.Access, error) {
+	c, err := ctrl.db.Query(ctx, edgeQuery, map[string]interface{}{
+		"@collection": schema.ACC2CHTS,
+		"fromDocID":   driver.NewDocumentID(noschema.ACCOUNTS_COL, account),
+		"toDocID":     driver.NewDocumentID(schema.CHATS_COL, chat),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	edge := &nograph.Access{}
+	_, err = c.ReadDocument(ctx, edge)
+	if driver.IsNoMoreDocuments(err) {
+		return nil, status.Error(codes.NotFound, "Account is not a member of the chat")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if edge.Role == "" {
+		edge.Role = roles.DEFAULT
+	}
+	return edge, nil
+}
+
+const addMemberQuery = `
+UPSERT { _from: @account, _to: @chat }
+    INSERT { _from: @account, _to: @chat, level: @level, role: @role }
+    UPDATE {}
+    IN @@collection
+RETURN IS_NULL(OLD)`
+
+// AddMember links account to the chat, existing members are left untouched.
+// Returns true if account wasn't a member before
+func (ctrl *ChatsController) AddMember(ctx context.Context, chat, account string, level int32) (bool, error) {
+	c, err := ctrl.db.Query(ctx, addMemberQuery, map[string]interface{}{
+		"@collection": schema.ACC2CHTS,
+		"chat":        driver.NewDocumentID(schema.CHATS_COL, chat),
+		"account":     driver.NewDocumentID(noschema.ACCOUNTS_COL, account),
+		"level":       level,
+		"role":        roles.DEFAULT,
+	})
+	if driver.IsConflict(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer c.Close()
+
+	var created bool
+	_, err = c.ReadDocument(ctx, &created)
+	return created, err
+}
+
+const listMembersQuery = `
+FOR account, edge IN 1 INBOUND @chat @@collection
+    FILTER account != null
+    SORT edge.level DESC, account.title
+    RETURN {
+        uuid: account._key, title: account.title,
+        access_level: edge.level, role: NOT_NULL(edge.role, @default),
+        read_seq: NOT_NULL(edge.read_seq, 0), read_at: NOT_NULL(edge.read_at, 0)
+    }`
+
+func (ctrl *ChatsController) ListMembers(ctx context.Context, chat string) ([]*pb.ChatMember, error) {
+	logger := ctrl.log.Named("ListMembers")
+	logger.Info("Listing chat members", zap.String("chat", chat))
+
+	if err := ChatAccess(ctx, ctrl.db, chat, access.READ); err != nil {
+		return nil, err
+	}
+
+	c, err := ctrl.db.Query(ctx, listMembersQuery, map[string]interface{}{
+		"@collection": schema.ACC2CHTS,
+		"chat":        driver.NewDocumentID(schema.CHATS_COL, chat),
+		"default":     roles.DEFAULT,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	members := []*pb.ChatMember{}
+	for {
+		member := &pb.ChatMember{}
+		_, err := c.ReadDocument(ctx, member)
+		if err != nil {
+			if driver.IsNoMoreDocuments(err) {
+				break
+			}
+			logger.Error("Failed to fetch chat members", zap.Error(err))
+			return nil, status.Error(codes.Internal, "Failed to fetch chat members")
+		}
+		members = append(members, member)
+	}
+
+	return members, nil
+}
+
+const memberOfQuery = `
+FOR chat, edge IN 1 OUTBOUND @account @@collection
+    FILTER chat != null && !chat.deleted_at && edge.level >= @level
+    RETURN chat._key`
+
+// MemberOf returns Chats requestor has at least given access level to
+func (ctrl *ChatsController) MemberOf(ctx context.Context, level int32) ([]string, error) {
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
+	c, err := ctrl.db.Query(ctx, memberOfQuery, map[string]interface{}{
+		"@collection": schema.ACC2CHTS,
+		"account":     driver.NewDocumentID(noschema.ACCOUNTS_COL, requestor),
+		"level":       level,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	chats := []string{}
+	for {
+		var chat string
+		_, err := c.ReadDocument(ctx, &chat)
+		if driver.IsNoMoreDocuments(err) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		chats = append(chats, chat)
+	}
+	return chats, nil
+}
+
+// Owners guard makes sure the last owner is never demoted or removed
+const updateMemberQuery = `
+LET owners = (
+    FOR edge IN @@collection
+        FILTER edge._to == @chat && edge.role == @owner
+        RETURN edge._key
+)
+FOR edge IN @@collection
+    FILTER edge._to == @chat && edge._from == @account
+    FILTER edge.role != @owner || @role == @owner || LENGTH(owners) > 1
+    UPDATE edge WITH { level: @level, role: @role } IN @@collection
+        OPTIONS { exclusive: true }
+    RETURN NEW`
+
+func (ctrl *ChatsController) SetMemberRole(ctx context.Context, req *pb.SetMemberRoleRequest) (*pb.ChatMember, error) {
+	logger := ctrl.log.Named("SetMemberRole")
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
+	logger.Info("Setting member role", zap.String("chat", req.GetChatUuid()), zap.String("user", req.GetUserUuid()), zap.String("role", req.GetRole()), zap.Int32("level", req.GetAccessLevel()))
+
+	role := req.GetRole()
+	if role == "" {
+		role = roles.DEFAULT
+	}
+	if role != roles.DEFAULT && role != roles.OWNER {
+		return nil, status.Error(codes.InvalidArgument, "Unknown role")
+	}
+	level := req.GetAccessLevel()
+	if role == roles.OWNER {
+		level = access.MGMT
+	}
+	if level < access.READ || level > access.MGMT {
+		return nil, status.Error(codes.InvalidArgument, "Access level must be either READ or MGMT")
+	}
+
+	own, err := ctrl.GetMember(ctx, req.GetChatUuid(), requestor)
+	if err != nil || own.Level < access.MGMT {
+		return nil, status.Error(codes.PermissionDenied, "Permission Denied")
+	}
+	member, err := ctrl.GetMember(ctx, req.GetChatUuid(), req.GetUserUuid())
+	if err != nil {
+		return nil, err
+	}
+	if (member.Role == roles.OWNER || role == roles.OWNER) && own.Role != roles.OWNER {
+		return nil, status.Error(codes.PermissionDenied, "Only owners can manage owners")
+	}
+
+	c, err := ctrl.db.Query(ctx, updateMemberQuery, map[string]interface{}{
+		"@collection": schema.ACC2CHTS,
+		"chat":        driver.NewDocumentID(schema.CHATS_COL, req.GetChatUuid()),
+		"account":     driver.NewDocumentID(noschema.ACCOUNTS_COL, req.GetUserUuid()),
+		"owner":       roles.OWNER,
+		"role":        role,
+		"level":       level,
+	})
+	if err != nil {
+		logger.Error("Failed to update member", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Failed to update member")
+	}
+	defer c.Close()
+
+	edge := &nograph.Access{}
+	_, err = c.ReadDocument(ctx, edge)
+	if driver.IsNoMoreDocuments(err) {
+		return nil, status.Error(codes.FailedPrecondition, "Chat must have at least one owner")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ChatMember{Uuid: req.GetUserUuid(), AccessLevel: edge.Level, Role: edge.Role}, nil
+}
+
+const removeMemberQuery = `
+LET owners = (
+    FOR edge IN @@collection
+        FILTER edge._to == @chat && edge.role == @owner
+        RETURN edge._key
+)
+FOR edge IN @@collection
+    FILTER edge._to == @chat && edge._from == @account
+    FILTER edge.role != @owner || LENGTH(owners) > 1
+    REMOVE edge IN @@collection
+        OPTIONS { exclusive: true }
+    RETURN OLD`
+
+func (ctrl *ChatsController) RemoveMember(ctx context.Context, chat, account string) error {
+	logger := ctrl.log.Named("RemoveMember")
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
+	logger.Info("Removing member", zap.String("chat", chat), zap.String("user", account))
+
+	if requestor != account {
+		own, err := ctrl.GetMember(ctx, chat, requestor)
+		if err != nil || own.Level < access.MGMT {
+			return status.Error(codes.PermissionDenied, "Permission Denied")
+		}
+		member, err := ctrl.GetMember(ctx, chat, account)
+		if err != nil {
+			return err
+		}
+		if member.Role == roles.OWNER && own.Role != roles.OWNER {
+			return status.Error(codes.PermissionDenied, "Only owners can manage owners")
+		}
+	} else if _, err := ctrl.GetMember(ctx, chat, account); err != nil {
+		return err
+	}
+
+	c, err := ctrl.db.Query(ctx, removeMemberQuery, map[string]interface{}{
+		"@collection": schema.ACC2CHTS,
+		"chat":        driver.NewDocumentID(schema.CHATS_COL, chat),
+		"account":     driver.NewDocumentID(noschema.ACCOUNTS_COL, account),
+		"owner":       roles.OWNER,
+	})
+	if err != nil {
+		logger.Error("Failed to remove member", zap.Error(err))
+		return status.Error(codes.Internal, "Failed to remove member")
+	}
+	defer c.Close()
+
+	_, err = c.ReadDocument(ctx, &nograph.Access{})
+	if driver.IsNoMoreDocuments(err) {
+		return status.Error(codes.FailedPrecondition, "Chat must have at least one owner, transfer ownership first")
+	}
+	return err
+}
+
+// LeaveChat removes requestor from the chat
+func (ctrl *ChatsController) LeaveChat(ctx context.Context, chat string) error {
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
+	return ctrl.RemoveMember(ctx, chat, requestor)
+}
+
+const transferOwnershipQuery = `
+FOR edge IN @@collection
+    FILTER edge._to == @chat && edge._from IN [@from, @to]
+    UPDATE edge WITH {
+        level: edge._from == @to ? @level : edge.level,
+        role: edge._from == @to ? @owner : @default
+    } IN @@collection
+        OPTIONS { exclusive: true }
+    RETURN NEW`
+
+// TransferOwnership makes account an owner of the chat, requestor keeps MGMT access as a default member
+func (ctrl *ChatsController) TransferOwnership(ctx context.Context, chat, account string) error {
+	logger := ctrl.log.Named("TransferOwnership")
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
+	logger.Info("Transferring ownership", zap.String("chat", chat), zap.String("from", requestor), zap.String("to", account))
+
+	own, err := ctrl.GetMember(ctx, chat, requestor)
+	if err != nil || own.Role != roles.OWNER {
+		return status.Error(codes.PermissionDenied, "Only owners can transfer ownership")
+	}
+	if requestor == account {
+		return nil
+	}
+	if _, err := ctrl.GetMember(ctx, chat, account); err != nil {
+		return err
+	}
+
+	c, err := ctrl.db.Query(ctx, transferOwnershipQuery, map[string]interface{}{
+		"@collection": schema.ACC2CHTS,
+		"chat":        driver.NewDocumentID(schema.CHATS_COL, chat),
+		"from":        driver.NewDocumentID(noschema.ACCOUNTS_COL, requestor),
+		"to":          driver.NewDocumentID(noschema.ACCOUNTS_COL, account),
+		"level":       access.MGMT,
+		"owner":       roles.OWNER,
+		"default":     roles.DEFAULT,
+	})
+	if err != nil {
+		logger.Error("Failed to transfer ownership", zap.Error(err))
+		return status.Error(codes.Internal, "Failed to transfer ownership")
+	}
+	return c.Close()
+}
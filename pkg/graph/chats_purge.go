@@ -0,0 +1,220 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/arangodb/go-driver"
+	pb "github.com/slntopp/nocloud-cc/cc"
+	"github.com/slntopp/nocloud-cc/pkg/schema"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var deleteChatQueries = []string{`
+FOR message IN @@messages
+    FILTER message.to == @chat
+    FOR edge IN @@acc2msg
+        FILTER edge._to == message._id
+        REMOVE edge IN @@acc2msg`, `
+FOR rev IN @@revisions
+    FILTER rev.chat == @chat
+    REMOVE rev IN @@revisions`, `
+FOR r IN @@reactions
+    FILTER r.chat == @chat
+    REMOVE r IN @@reactions`, `
+FOR message IN @@messages
+    FILTER message.to == @chat
+    REMOVE message IN @@messages`, `
+FOR edge IN @@acc2chts
+    FILTER edge._to == @chatID
+    REMOVE edge IN @@acc2chts`, `
+FOR invite IN @@invites
+    FILTER invite.chat == @chat
+    REMOVE invite IN @@invites`, `
+FOR link IN @@links
+    FILTER link.chat == @chat
+    REMOVE link IN @@links`, `
+FOR record IN @@outbox
+    FILTER record.routing_key == @chat
+    REMOVE record IN @@outbox`,
+}
+
+// Purge Chat deleted before cutoff (unix milliseconds) together with its messages, members,
+// invites, links and outbox records in one transaction. Returns false if Chat has been restored meanwhile
+func (ctrl *ChatsController) Purge(ctx context.Context, id string, cutoff int64) (bool, error) {
+	logger := ctrl.log.Named("PurgeChat")
+	logger.Info("Purging chat", zap.String("id", id))
+
+	tid, err := ctrl.db.BeginTransaction(ctx, driver.TransactionCollections{
+		Write: []string{
+			schema.CHATS_COL, schema.CHATS_MESSAGES_COL, schema.ACC2CHTS, schema.ACC2MSG,
+			schema.CHATS_INVITES_COL, schema.CHATS_LINKS_COL, schema.CHATS_REVISIONS_COL,
+			schema.CHATS_REACTIONS_COL, schema.CHATS_OUTBOX_COL,
+		},
+	}, nil)
+	if err != nil {
+		logger.Error("Failed to start transaction", zap.Error(err))
+		return false, status.Error(codes.Internal, "Failed to purge chat")
+	}
+	trCtx := driver.WithTransactionID(ctx, tid)
+
+	abort := func(err error) (bool, error) {
+		logger.Error("Failed to purge chat", zap.String("id", id), zap.Error(err))
+		if err := ctrl.db.AbortTransaction(ctx, tid, nil); err != nil {
+			logger.Warn("Failed to abort transaction", zap.Error(err))
+		}
+		return false, status.Error(codes.Internal, "Failed to purge chat")
+	}
+
+	// Restore committed before the transaction is seen here, concurrent one conflicts with removing the Chat
+	chat := &pb.Chat{}
+	_, err = ctrl.col.ReadDocument(trCtx, id, chat)
+	if err != nil {
+		return abort(err)
+	}
+	if chat.GetDeletedAt() == 0 || chat.GetDeletedAt() >= cutoff {
+		logger.Info("Chat has been restored, skipping", zap.String("id", id))
+		if err := ctrl.db.AbortTransaction(ctx, tid, nil); err != nil {
+			logger.Warn("Failed to abort transaction", zap.Error(err))
+		}
+		return false, nil
+	}
+
+	vars := map[string]interface{}{
+		"chat":       id,
+		"chatID":     driver.NewDocumentID(schema.CHATS_COL, id),
+		"@messages":  schema.CHATS_MESSAGES_COL,
+		"@acc2msg":   schema.ACC2MSG,
+		"@revisions": schema.CHATS_REVISIONS_COL,
+		"@reactions": schema.CHATS_REACTIONS_COL,
+		"@acc2chts":  schema.ACC2CHTS,
+		"@invites":   schema.CHATS_INVITES_COL,
+		"@links":     schema.CHATS_LINKS_COL,
+		"@outbox":    schema.CHATS_OUTBOX_COL,
+	}
+	for _, query := range deleteChatQueries {
+		c, err := ctrl.db.Query(trCtx, query, bindVars(query, vars))
+		if err != nil {
+			return abort(err)
+		}
+		c.Close()
+	}
+
+	_, err = ctrl.col.RemoveDocument(trCtx, id)
+	if err != nil {
+		return abort(err)
+	}
+
+	err = ctrl.db.CommitTransaction(ctx, tid, nil)
+	if err != nil {
+		logger.Error("Failed to commit transaction", zap.Error(err))
+		return false, status.Error(codes.Internal, "Failed to purge chat")
+	}
+	return true, nil
+}
+
+const deletedChatsQuery = `
+FOR chat IN @@collection
+    FILTER chat.deleted_at > 0 && chat.deleted_at < @cutoff
+    RETURN chat._key`
+
+// PurgeDeleted purges Chats deleted before cutoff (unix milliseconds)
+func (ctrl *ChatsController) PurgeDeleted(ctx context.Context, cutoff int64) (int, error) {
+	c, err := ctrl.db.Query(ctx, deletedChatsQuery, map[string]interface{}{
+		"@collection": schema.CHATS_COL,
+		"cutoff":      cutoff,
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer c.Close()
+
+	var chats []string
+	for {
+		var key string
+		_, err := c.ReadDocument(ctx, &key)
+		if driver.IsNoMoreDocuments(err) {
+			break
+		} else if err != nil {
+			return 0, err
+		}
+		chats = append(chats, key)
+	}
+
+	purged := 0
+	for _, chat := range chats {
+		ok, err := ctrl.Purge(ctx, chat, cutoff)
+		if err != nil {
+			return purged, err
+		}
+		if ok {
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+var purgeMessagesQueries = []string{`
+FOR message IN @@messages
+    FILTER message.deleted_at > 0 && message.deleted_at < @cutoff
+    FOR edge IN @@acc2msg
+        FILTER edge._to == message._id
+        REMOVE edge IN @@acc2msg`, `
+FOR message IN @@messages
+    FILTER message.deleted_at > 0 && message.deleted_at < @cutoff
+    FOR rev IN @@revisions
+        FILTER rev.message_uuid == message._key
+        REMOVE rev IN @@revisions`, `
+FOR message IN @@messages
+    FILTER message.deleted_at > 0 && message.deleted_at < @cutoff
+    FOR r IN @@reactions
+        FILTER r.message == message._key
+        REMOVE r IN @@reactions`, `
+FOR message IN @@messages
+    FILTER message.deleted_at > 0 && message.deleted_at < @cutoff
+    REMOVE message IN @@messages`,
+}
+
+// PurgeDeleted purges messages deleted before cutoff (unix milliseconds) together with their
+// access edges, revisions and reactions in one transaction, so restored messages are kept whole
+func (ctrl *ChatsMessagesController) PurgeDeleted(ctx context.Context, cutoff int64) error {
+	logger := ctrl.log.Named("PurgeDeleted")
+
+	tid, err := ctrl.db.BeginTransaction(ctx, driver.TransactionCollections{
+		Write: []string{
+			schema.CHATS_MESSAGES_COL, schema.ACC2MSG, schema.CHATS_REVISIONS_COL, schema.CHATS_REACTIONS_COL,
+		},
+	}, nil)
+	if err != nil {
+		logger.Error("Failed to start transaction", zap.Error(err))
+		return status.Error(codes.Internal, "Failed to purge messages")
+	}
+	trCtx := driver.WithTransactionID(ctx, tid)
+
+	vars := map[string]interface{}{
+		"@messages":  schema.CHATS_MESSAGES_COL,
+		"@acc2msg":   schema.ACC2MSG,
+		"@revisions": schema.CHATS_REVISIONS_COL,
+		"@reactions": schema.CHATS_REACTIONS_COL,
+		"cutoff":     cutoff,
+	}
+	for _, query := range purgeMessagesQueries {
+		c, err := ctrl.db.Query(trCtx, query, bindVars(query, vars))
+		if err != nil {
+			logger.Error("Failed to purge messages", zap.Error(err))
+			if err := ctrl.db.AbortTransaction(ctx, tid, nil); err != nil {
+				logger.Warn("Failed to abort transaction", zap.Error(err))
+			}
+			return status.Error(codes.Internal, "Failed to purge messages")
+		}
+		c.Close()
+	}
+
+	err = ctrl.db.CommitTransaction(ctx, tid, nil)
+	if err != nil {
+		logger.Error("Failed to commit transaction", zap.Error(err))
+		return status.Error(codes.Internal, "Failed to purge messages")
+	}
+	return nil
+}
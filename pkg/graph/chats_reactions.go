@@ -0,0 +1,173 @@
+package graph
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/arangodb/go-driver"
+	pb "github.com/slntopp/nocloud-cc/cc"
+	"github.com/slntopp/nocloud-cc/pkg/schema"
+	"github.com/slntopp/nocloud/pkg/nocloud"
+	"github.com/slntopp/nocloud/pkg/nocloud/access"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Reactions are short strings (emojis or shortcodes), this caps their length in runes
+const MaxReactionLength = 32
+
+type Reaction struct {
+	Message   string `json:"message"`
+	Chat      string `json:"chat"`
+	Account   string `json:"account"`
+	Emoji     string `json:"emoji"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+// reactionTarget checks requestor can react to the message and returns it
+func (ctrl *ChatsMessagesController) reactionTarget(ctx context.Context, id, emoji string) (*pb.ChatMessage, error) {
+	if emoji == "" || utf8.RuneCountInString(emoji) > MaxReactionLength {
+		return nil, status.Error(codes.InvalidArgument, "Invalid reaction")
+	}
+
+	msg := &pb.ChatMessage{}
+	_, err := ctrl.col.ReadDocument(ctx, id, msg)
+	if driver.IsNotFound(err) || (err == nil && msg.GetDeletedAt() != 0) {
+		return nil, status.Error(codes.NotFound, "Message not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := ChatAccess(ctx, ctrl.db, msg.GetTo(), access.READ); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// AddReaction to the message, reacting with the same emoji twice is a no-op
+func (ctrl *ChatsMessagesController) AddReaction(ctx context.Context, id, emoji string) (*ChatMessage, error) {
+	logger := ctrl.log.Named("AddReaction")
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
+	logger.Info("Adding reaction", zap.String("message", id), zap.String("emoji", emoji), zap.String("account", requestor))
+
+	msg, err := ctrl.reactionTarget(ctx, id, emoji)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = ctrl.reactions.CreateDocument(ctx, Reaction{
+		Message:   id,
+		Chat:      msg.GetTo(),
+		Account:   requestor,
+		Emoji:     emoji,
+		CreatedAt: time.Now().UnixMilli(),
+	})
+	if err != nil && !driver.IsConflict(err) {
+		logger.Error("Failed to add reaction", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Failed to add reaction")
+	}
+
+	return ctrl.reacted(ctx, id)
+}
+
+const removeReactionQuery = `
+FOR r IN @@collection
+    FILTER r.message == @message && r.account == @account && r.emoji == @emoji
+    REMOVE r IN @@collection`
+
+// RemoveReaction from the message, removing missing reaction is a no-op
+func (ctrl *ChatsMessagesController) RemoveReaction(ctx context.Context, id, emoji string) (*ChatMessage, error) {
+	logger := ctrl.log.Named("RemoveReaction")
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
+	logger.Info("Removing reaction", zap.String("message", id), zap.String("emoji", emoji), zap.String("account", requestor))
+
+	_, err := ctrl.reactionTarget(ctx, id, emoji)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := ctrl.db.Query(ctx, removeReactionQuery, map[string]interface{}{
+		"@collection": schema.CHATS_REACTIONS_COL,
+		"message":     id,
+		"account":     requestor,
+		"emoji":       emoji,
+	})
+	if err != nil {
+		logger.Error("Failed to remove reaction", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Failed to remove reaction")
+	}
+	c.Close()
+
+	return ctrl.reacted(ctx, id)
+}
+
+// reacted reads message with up to date reactions
+func (ctrl *ChatsMessagesController) reacted(ctx context.Context, id string) (*ChatMessage, error) {
+	msg := &pb.ChatMessage{}
+	meta, err := ctrl.col.ReadDocument(ctx, id, msg)
+	if err != nil {
+		return nil, err
+	}
+	msg.Uuid = meta.ID.Key()
+	msg.Rev = meta.Rev
+	RenderDeleted(msg)
+
+	if err := ctrl.WithReactions(ctx, msg); err != nil {
+		return nil, err
+	}
+	return &ChatMessage{msg, meta}, nil
+}
+
+const aggregateReactionsQuery = `
+FOR r IN @@collection
+    FILTER r.message IN @messages
+    COLLECT message = r.message, emoji = r.emoji INTO accounts = r.account
+    SORT LENGTH(accounts) DESC, emoji
+    RETURN { message, emoji, count: LENGTH(accounts), accounts }`
+
+// WithReactions sets aggregated reactions on the given messages
+func (ctrl *ChatsMessagesController) WithReactions(ctx context.Context, msgs ...*pb.ChatMessage) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+	index := make(map[string]*pb.ChatMessage, len(msgs))
+	keys := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		msg.Reactions = nil
+		index[msg.GetUuid()] = msg
+		keys = append(keys, msg.GetUuid())
+	}
+
+	c, err := ctrl.db.Query(ctx, aggregateReactionsQuery, map[string]interface{}{
+		"@collection": schema.CHATS_REACTIONS_COL,
+		"messages":    keys,
+	})
+	if err != nil {
+		ctrl.log.Error("Failed to aggregate reactions", zap.Error(err))
+		return status.Error(codes.Internal, "Failed to fetch reactions")
+	}
+	defer c.Close()
+
+	for {
+		var reaction struct {
+			Message string `json:"message"`
+			*pb.Reaction
+		}
+		reaction.Reaction = &pb.Reaction{}
+		_, err := c.ReadDocument(ctx, &reaction)
+		if err != nil {
+			if driver.IsNoMoreDocuments(err) {
+				break
+			}
+			ctrl.log.Error("Failed to fetch reactions", zap.Error(err))
+			return status.Error(codes.Internal, "Failed to fetch reactions")
+		}
+		if msg, ok := index[reaction.Message]; ok {
+			msg.Reactions = append(msg.Reactions, reaction.Reaction)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package graph
+
+import (
+	"context"
+	"time"
+
+	"github.com/arangodb/go-driver"
+	pb "github.com/slntopp/nocloud-cc/cc"
+	"github.com/slntopp/nocloud-cc/pkg/schema"
+	"github.com/slntopp/nocloud/pkg/nocloud"
+	noschema "github.com/slntopp/nocloud/pkg/nocloud/schema"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Read cursor is stored on the Accounts2Chats edge and only moves forward
+const markReadQuery = `
+FOR edge IN @@collection
+    FILTER edge._from == @account && edge._to == @chat
+    LET advance = @seq > NOT_NULL(edge.read_seq, 0)
+    UPDATE edge WITH advance ? { read_seq: @seq, read_at: @now } : {} IN @@collection
+        OPTIONS { exclusive: true }
+    RETURN { read_seq: NEW.read_seq, read_at: NEW.read_at, advanced: advance }`
+
+// MarkRead moves requestor read cursor up to the given message, or to the latest one if empty.
+// Returns true if cursor has moved
+func (ctrl *ChatsController) MarkRead(ctx context.Context, chat, message string) (*pb.ReadReceipt, bool, error) {
+	logger := ctrl.log.Named("MarkRead")
+	requestor := ctx.Value(nocloud.NoCloudAccount).(string)
+	logger.Info("Marking chat read", zap.String("chat", chat), zap.String("message", message), zap.String("account", requestor))
+
+	if _, err := ctrl.GetMember(ctx, chat, requestor); err != nil {
+		return nil, false, status.Error(codes.PermissionDenied, "Permission Denied")
+	}
+	if err := LiveChat(ctx, ctrl.db, chat); err != nil {
+		return nil, false, err
+	}
+
+	var seq int64
+	if message == "" {
+		c := &pb.Chat{}
+		_, err := ctrl.col.ReadDocument(ctx, chat, c)
+		if err != nil {
+			return nil, false, err
+		}
+		seq = c.GetLastSeq()
+	} else {
+		messages, err := ctrl.db.Collection(ctx, schema.CHATS_MESSAGES_COL)
+		if err != nil {
+			return nil, false, err
+		}
+		msg := &pb.ChatMessage{}
+		_, err = messages.ReadDocument(ctx, message, msg)
+		if driver.IsNotFound(err) || (err == nil && msg.GetTo() != chat) {
+			return nil, false, status.Error(codes.InvalidArgument, "Message doesn't belong to the chat")
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		seq = msg.GetSeq()
+	}
+
+	c, err := ctrl.db.Query(ctx, markReadQuery, map[string]interface{}{
+		"@collection": schema.ACC2CHTS,
+		"account":     driver.NewDocumentID(noschema.ACCOUNTS_COL, requestor),
+		"chat":        driver.NewDocumentID(schema.CHATS_COL, chat),
+		"seq":         seq,
+		"now":         time.Now().UnixMilli(),
+	})
+	if err != nil {
+		logger.Error("Failed to mark chat read", zap.Error(err))
+		return nil, false, status.Error(codes.Internal, "Failed to mark chat read")
+	}
+	defer c.Close()
+
+	var res struct {
+		ReadSeq  int64 `json:"read_seq"`
+		ReadAt   int64 `json:"read_at"`
+		Advanced bool  `json:"advanced"`
+	}
+	_, err = c.ReadDocument(ctx, &res)
+	if driver.IsNoMoreDocuments(err) {
+		return nil, false, status.Error(codes.PermissionDenied, "Permission Denied")
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &pb.ReadReceipt{
+		Chat: chat, Account: requestor,
+		ReadSeq: res.ReadSeq, ReadAt: res.ReadAt,
+	}, res.Advanced, nil
+}
@@ -0,0 +1,125 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/arangodb/go-driver"
+	pb "github.com/slntopp/nocloud-cc/cc"
+	"github.com/slntopp/nocloud-cc/pkg/schema"
+	"github.com/slntopp/nocloud/pkg/nocloud/access"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MessageSeq returns seq of the message, message must belong to the chat
+func (ctrl *ChatsMessagesController) MessageSeq(ctx context.Context, chat, id string) (int64, error) {
+	msg := &pb.ChatMessage{}
+	_, err := ctrl.col.ReadDocument(ctx, id, msg)
+	if driver.IsNotFound(err) || (err == nil && msg.GetTo() != chat) {
+		return 0, status.Error(codes.InvalidArgument, "Message doesn't belong to the chat")
+	}
+	if err != nil {
+		return 0, err
+	}
+	return msg.GetSeq(), nil
+}
+
+const replayQuery = `
+FOR message IN @@collection
+    FILTER message.to == @chat && message.seq > @since
+    SORT message.seq ASC
+    LIMIT @limit
+    RETURN message`
+
+// Replay returns up to limit messages of the chat with seq greater than since, oldest first
+func (ctrl *ChatsMessagesController) Replay(ctx context.Context, chat string, since int64, limit int) ([]*pb.ChatMessage, error) {
+	logger := ctrl.log.Named("Replay")
+	logger.Debug("Replaying messages", zap.String("chat", chat), zap.Int64("since", since))
+
+	if err := ChatAccess(ctx, ctrl.db, chat, access.READ); err != nil {
+		return nil, err
+	}
+
+	c, err := ctrl.db.Query(ctx, replayQuery, map[string]interface{}{
+		"@collection": schema.CHATS_MESSAGES_COL,
+		"chat":        chat,
+		"since":       since,
+		"limit":       limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	messages := []*pb.ChatMessage{}
+	for {
+		message := &pb.ChatMessage{}
+		meta, err := c.ReadDocument(ctx, message)
+		if err != nil {
+			if driver.IsNoMoreDocuments(err) {
+				break
+			}
+			logger.Error("Failed to replay messages", zap.Error(err))
+			return nil, status.Error(codes.Internal, "Failed to replay messages")
+		}
+		message.Uuid = meta.ID.Key()
+		message.Rev = meta.Rev
+		RenderDeleted(message)
+		messages = append(messages, message)
+	}
+
+	if err := ctrl.WithReactions(ctx, messages...); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+const replayAllQuery = `
+FOR message IN @@collection
+    FILTER message.to IN @chats
+    FILTER message.sent_at > @since || (@key != "" && message.sent_at == @since && message._key > @key)
+    SORT message.sent_at ASC, message._key ASC
+    LIMIT @limit
+    RETURN message`
+
+// ReplayAll returns up to limit messages of the given chats sent after the cursor, oldest first.
+// Cursor without key stands for the time alone. Chats access must be checked by the caller
+func (ctrl *ChatsMessagesController) ReplayAll(ctx context.Context, chats []string, since *Cursor, limit int) ([]*pb.ChatMessage, error) {
+	logger := ctrl.log.Named("ReplayAll")
+	logger.Debug("Replaying messages", zap.Int("chats", len(chats)), zap.Int64("since", since.Value))
+
+	c, err := ctrl.db.Query(ctx, replayAllQuery, map[string]interface{}{
+		"@collection": schema.CHATS_MESSAGES_COL,
+		"chats":       chats,
+		"since":       since.Value,
+		"key":         since.Key,
+		"limit":       limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	messages := []*pb.ChatMessage{}
+	for {
+		message := &pb.ChatMessage{}
+		meta, err := c.ReadDocument(ctx, message)
+		if err != nil {
+			if driver.IsNoMoreDocuments(err) {
+				break
+			}
+			logger.Error("Failed to replay messages", zap.Error(err))
+			return nil, status.Error(codes.Internal, "Failed to replay messages")
+		}
+		message.Uuid = meta.ID.Key()
+		message.Rev = meta.Rev
+		RenderDeleted(message)
+		messages = append(messages, message)
+	}
+
+	if err := ctrl.WithReactions(ctx, messages...); err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
@@ -0,0 +1,161 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arangodb/go-driver"
+	pb "github.com/slntopp/nocloud-cc/cc"
+	"github.com/slntopp/nocloud-cc/pkg/schema"
+	"github.com/slntopp/nocloud/pkg/nocloud/access"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// threadRoot validates replied message and returns the thread it belongs to.
+// Threads are one level deep, replying to a reply continues its parent thread
+func (ctrl *ChatsMessagesController) threadRoot(ctx context.Context, msg *pb.ChatMessage) (string, error) {
+	parent := &pb.ChatMessage{}
+	meta, err := ctrl.col.ReadDocument(ctx, msg.GetReplyTo(), parent)
+	if driver.IsNotFound(err) || (err == nil && parent.GetDeletedAt() != 0) {
+		return "", status.Error(codes.InvalidArgument, "Replied message not found")
+	}
+	if err != nil {
+		return "", err
+	}
+	if parent.GetTo() != msg.GetTo() {
+		return "", status.Error(codes.InvalidArgument, "Replied message belongs to another chat")
+	}
+	if parent.GetReplyTo() != "" {
+		return parent.GetReplyTo(), nil
+	}
+	return meta.ID.Key(), nil
+}
+
+const bumpThreadQuery = `
+FOR msg IN @@collection
+    FILTER msg._key == @key
+    UPDATE msg WITH { reply_count: NOT_NULL(msg.reply_count, 0) + 1, last_reply_at: @now } IN @@collection
+        OPTIONS { exclusive: true }
+    RETURN NEW`
+
+// bumpThread counts new reply on the thread parent, returns updated parent
+func (ctrl *ChatsMessagesController) bumpThread(ctx context.Context, id string, now int64) (*ChatMessage, error) {
+	c, err := ctrl.db.Query(ctx, bumpThreadQuery, map[string]interface{}{
+		"@collection": schema.CHATS_MESSAGES_COL,
+		"key":         id,
+		"now":         now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	parent := &pb.ChatMessage{}
+	meta, err := c.ReadDocument(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+	parent.Uuid = meta.ID.Key()
+	parent.Rev = meta.Rev
+	RenderDeleted(parent)
+
+	if err := ctrl.WithReactions(ctx, parent); err != nil {
+		return nil, err
+	}
+	return &ChatMessage{parent, meta}, nil
+}
+
+var listThreadQuery = `
+FOR message IN @@collection
+    FILTER message.reply_to == @parent
+    %s
+    SORT message.seq ASC, message._key ASC
+    LIMIT @limit
+    RETURN message`
+
+// ListThread returns thread parent and its replies in chronological order
+func (ctrl *ChatsMessagesController) ListThread(ctx context.Context, req *pb.ListThreadRequest) (*pb.ListThreadResponse, error) {
+	logger := ctrl.log.Named("ListThread")
+	logger.Info("Fetching thread", zap.String("parent", req.GetUuid()), zap.String("cursor", req.GetCursor()))
+
+	parent := &pb.ChatMessage{}
+	meta, err := ctrl.col.ReadDocument(ctx, req.GetUuid(), parent)
+	if driver.IsNotFound(err) {
+		return nil, status.Error(codes.NotFound, "Message not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := ChatAccess(ctx, ctrl.db, parent.GetTo(), access.READ); err != nil {
+		return nil, err
+	}
+	parent.Uuid = meta.ID.Key()
+	parent.Rev = meta.Rev
+	RenderDeleted(parent)
+
+	limit := req.GetLimit()
+	if limit <= 0 {
+		limit = DefaultMessagesPageSize
+	} else if limit > MaxMessagesPageSize {
+		limit = MaxMessagesPageSize
+	}
+
+	vars := map[string]interface{}{
+		"parent":      parent.GetUuid(),
+		"@collection": schema.CHATS_MESSAGES_COL,
+		"limit":       limit + 1,
+	}
+	filter := ""
+	if req.GetCursor() != "" {
+		cursor, err := DecodeCursor(req.GetCursor())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Invalid cursor")
+		}
+		filter = fmt.Sprintf(listCursorFilter, ">")
+		vars["seq"] = cursor.Value
+		vars["key"] = cursor.Key
+	}
+
+	c, err := ctrl.db.Query(ctx, fmt.Sprintf(listThreadQuery, filter), vars)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	replies := []*pb.ChatMessage{}
+	for {
+		reply := &pb.ChatMessage{}
+		meta, err := c.ReadDocument(ctx, reply)
+		if err != nil {
+			if driver.IsNoMoreDocuments(err) {
+				break
+			}
+			logger.Error("Failed to fetch thread replies", zap.Error(err))
+			return nil, status.Error(codes.Internal, "Failed to fetch thread replies")
+		}
+		reply.Uuid = meta.ID.Key()
+		reply.Rev = meta.Rev
+		RenderDeleted(reply)
+		replies = append(replies, reply)
+	}
+
+	res := &pb.ListThreadResponse{Parent: parent}
+	if len(replies) > int(limit) {
+		replies = replies[:limit]
+		res.HasMore = true
+	}
+	if err := ctrl.WithReactions(ctx, append([]*pb.ChatMessage{parent}, replies...)...); err != nil {
+		return nil, err
+	}
+	if len(replies) > 0 {
+		last := replies[len(replies)-1]
+		res.NextCursor = EncodeCursor(&Cursor{Value: last.Seq, Key: last.Uuid})
+	} else {
+		res.NextCursor = req.GetCursor()
+	}
+	res.Replies = replies
+
+	return res, nil
+}
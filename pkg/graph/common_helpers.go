@@ -4,11 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"regexp"
 	"strings"
 
 	"github.com/arangodb/go-driver"
 	nograph "github.com/slntopp/nocloud/pkg/graph"
 	"github.com/slntopp/nocloud/pkg/nocloud"
+	"github.com/slntopp/nocloud/pkg/nocloud/access"
 	noschema "github.com/slntopp/nocloud/pkg/nocloud/schema"
 )
 
@@ -90,3 +92,24 @@ func HasAccess(ctx context.Context, db driver.Database, collection, node string,
 
 	return access.Level >= level
 }
+
+// bindVars picks only variables used in the query, as ArangoDB rejects unused bind parameters
+func bindVars(query string, vars map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for key, val := range vars {
+		if regexp.MustCompile("@" + regexp.QuoteMeta(key) + `\b`).MatchString(query) {
+			result[key] = val
+		}
+	}
+	return result
+}
+
+// HasRootAccess checks requestor administrates the root namespace
+func HasRootAccess(ctx context.Context, db driver.Database) bool {
+	payload := ctx.Value(nocloud.NoCloudAccount)
+	if payload == nil {
+		return false
+	}
+	root := driver.NewDocumentID(noschema.NAMESPACES_COL, noschema.ROOT_NAMESPACE_KEY)
+	return nograph.HasAccess(ctx, db, payload.(string), root.String(), access.ADMIN)
+}
@@ -0,0 +1,224 @@
+package graph
+
+import (
+	"context"
+	"time"
+
+	"github.com/arangodb/go-driver"
+	pb "github.com/slntopp/nocloud-cc/cc"
+	"github.com/slntopp/nocloud-cc/pkg/schema"
+	nograph "github.com/slntopp/nocloud/pkg/graph"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// OutboxRecord is stored with the lease, claimed records aren't published by other relays until it expires
+type OutboxRecord struct {
+	*pb.OutboxRecord
+	LockedUntil int64 `json:"locked_until"`
+}
+
+type OutboxController struct {
+	log *zap.Logger
+	db  driver.Database
+	col driver.Collection
+}
+
+func NewOutboxController(logger *zap.Logger, db driver.Database) OutboxController {
+	ctx := context.TODO()
+	log := logger.Named("OutboxController")
+	log.Info("Creating OutboxController")
+
+	col := nograph.GetEnsureCollection(log, ctx, db, schema.CHATS_OUTBOX_COL)
+	/* #nosec */
+	col.EnsurePersistentIndex(ctx, []string{"published_at", "created_at"}, &driver.EnsurePersistentIndexOptions{
+		Unique: false, Sparse: false, InBackground: true, Name: "outbox-published-created",
+	})
+
+	return OutboxController{log: log, db: db, col: col}
+}
+
+// NewOutboxRecord for the event, routed to the chat it belongs to
+func NewOutboxRecord(event *pb.ChatEvent) (*OutboxRecord, error) {
+	body, err := protojson.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	return &OutboxRecord{OutboxRecord: &pb.OutboxRecord{
+		RoutingKey: event.GetChatUuid(),
+		Type:       event.GetType().String(),
+		Body:       string(body),
+		CreatedAt:  event.GetAt(),
+	}}, nil
+}
+
+const claimOutboxQuery = `
+FOR r IN @@collection
+    FILTER r.published_at == null && r.locked_until < @now
+    SORT r.created_at ASC, TO_NUMBER(r._key) ASC
+    LIMIT @limit
+    UPDATE r WITH { locked_until: @until, attempts: r.attempts + 1 } IN @@collection
+        OPTIONS { exclusive: true }
+    RETURN NEW`
+
+// Claim leases up to limit pending records, oldest first. Records created at the same time
+// are claimed in insertion order, so thread parent update follows the reply it was written with
+func (ctrl *OutboxController) Claim(ctx context.Context, limit int, lease time.Duration) ([]*pb.OutboxRecord, error) {
+	now := time.Now()
+	c, err := ctrl.db.Query(ctx, claimOutboxQuery, map[string]interface{}{
+		"@collection": schema.CHATS_OUTBOX_COL,
+		"now":         now.UnixMilli(),
+		"until":       now.Add(lease).UnixMilli(),
+		"limit":       limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	return readOutbox(ctx, c)
+}
+
+func readOutbox(ctx context.Context, c driver.Cursor) ([]*pb.OutboxRecord, error) {
+	records := []*pb.OutboxRecord{}
+	for {
+		record := &pb.OutboxRecord{}
+		meta, err := c.ReadDocument(ctx, record)
+		if driver.IsNoMoreDocuments(err) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		record.Uuid = meta.ID.Key()
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func (ctrl *OutboxController) MarkPublished(ctx context.Context, id string) error {
+	_, err := ctrl.col.UpdateDocument(driver.WithKeepNull(ctx, false), id, map[string]interface{}{
+		"published_at": time.Now().UnixMilli(),
+		"last_error":   nil,
+	})
+	return err
+}
+
+// MarkFailed releases the lease, so record is retried right away
+func (ctrl *OutboxController) MarkFailed(ctx context.Context, id string, cause error) error {
+	_, err := ctrl.col.UpdateDocument(ctx, id, map[string]interface{}{
+		"last_error":   cause.Error(),
+		"locked_until": 0,
+	})
+	return err
+}
+
+const listOutboxQuery = `
+FOR r IN @@collection
+    FILTER !@pending || r.published_at == null
+    SORT r.created_at DESC
+    LIMIT @limit
+    RETURN r`
+
+func (ctrl *OutboxController) List(ctx context.Context, req *pb.ListOutboxRequest) (*pb.ListOutboxResponse, error) {
+	logger := ctrl.log.Named("ListOutbox")
+	logger.Info("Fetching outbox", zap.Bool("pending", req.GetPending()))
+
+	if !HasRootAccess(ctx, ctrl.db) {
+		return nil, status.Error(codes.PermissionDenied, "Permission Denied")
+	}
+
+	limit := req.GetLimit()
+	if limit <= 0 {
+		limit = DefaultMessagesPageSize
+	} else if limit > MaxMessagesPageSize {
+		limit = MaxMessagesPageSize
+	}
+
+	c, err := ctrl.db.Query(ctx, listOutboxQuery, map[string]interface{}{
+		"@collection": schema.CHATS_OUTBOX_COL,
+		"pending":     req.GetPending(),
+		"limit":       limit,
+	})
+	if err != nil {
+		logger.Error("Failed to fetch outbox", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Failed to fetch outbox")
+	}
+	defer c.Close()
+
+	records, err := readOutbox(ctx, c)
+	if err != nil {
+		logger.Error("Failed to fetch outbox", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Failed to fetch outbox")
+	}
+	return &pb.ListOutboxResponse{Records: records}, nil
+}
+
+// Published records are made pending again, the ones still pending are left to the relay
+const replayOutboxQuery = `
+FOR r IN @@collection
+    FILTER LENGTH(@keys) > 0 ? r._key IN @keys : r.created_at >= @since
+    FILTER r.published_at != null
+    UPDATE r WITH { published_at: null, locked_until: 0, attempts: 0 } IN @@collection
+        OPTIONS { keepNull: false }
+    RETURN NEW._key`
+
+// Replay republishes given records, or every record created since given time
+func (ctrl *OutboxController) Replay(ctx context.Context, req *pb.ReplayOutboxRequest) (int64, error) {
+	logger := ctrl.log.Named("ReplayOutbox")
+	logger.Info("Replaying outbox", zap.Strings("uuids", req.GetUuids()), zap.Int64("since", req.GetSince()))
+
+	if !HasRootAccess(ctx, ctrl.db) {
+		return 0, status.Error(codes.PermissionDenied, "Permission Denied")
+	}
+	if len(req.GetUuids()) == 0 && req.GetSince() <= 0 {
+		return 0, status.Error(codes.InvalidArgument, "Either uuids or since must be given")
+	}
+
+	keys := req.GetUuids()
+	if keys == nil {
+		keys = []string{}
+	}
+	c, err := ctrl.db.Query(ctx, replayOutboxQuery, map[string]interface{}{
+		"@collection": schema.CHATS_OUTBOX_COL,
+		"keys":        keys,
+		"since":       req.GetSince(),
+	})
+	if err != nil {
+		logger.Error("Failed to replay outbox", zap.Error(err))
+		return 0, status.Error(codes.Internal, "Failed to replay outbox")
+	}
+	defer c.Close()
+
+	var count int64
+	for {
+		var key string
+		_, err := c.ReadDocument(ctx, &key)
+		if driver.IsNoMoreDocuments(err) {
+			break
+		} else if err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+const purgeOutboxQuery = `
+FOR r IN @@collection
+    FILTER r.published_at != null && r.published_at < @cutoff
+    REMOVE r IN @@collection`
+
+// PurgePublished removes records published before cutoff (unix milliseconds)
+func (ctrl *OutboxController) PurgePublished(ctx context.Context, cutoff int64) error {
+	c, err := ctrl.db.Query(ctx, purgeOutboxQuery, map[string]interface{}{
+		"@collection": schema.CHATS_OUTBOX_COL,
+		"cutoff":      cutoff,
+	})
+	if err != nil {
+		return err
+	}
+	c.Close()
+	return nil
+}
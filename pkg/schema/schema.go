@@ -3,8 +3,13 @@ package schema
 import "github.com/slntopp/nocloud/pkg/nocloud/schema"
 
 const (
-	CHATS_COL          = "Chats"
-	CHATS_MESSAGES_COL = "ChatsMessages"
-	ACC2CHTS           = schema.ACCOUNTS_COL + "2" + CHATS_COL
-	ACC2MSG            = schema.ACCOUNTS_COL + "2" + CHATS_MESSAGES_COL
+	CHATS_COL           = "Chats"
+	CHATS_MESSAGES_COL  = "ChatsMessages"
+	CHATS_INVITES_COL   = "ChatsInvites"
+	CHATS_LINKS_COL     = "ChatsInviteLinks"
+	CHATS_REVISIONS_COL = "ChatsMessagesRevisions"
+	CHATS_REACTIONS_COL = "ChatsMessagesReactions"
+	CHATS_OUTBOX_COL    = "ChatsOutbox"
+	ACC2CHTS            = schema.ACCOUNTS_COL + "2" + CHATS_COL
+	ACC2MSG             = schema.ACCOUNTS_COL + "2" + CHATS_MESSAGES_COL
 )